@@ -91,6 +91,17 @@ func TestTLSECDSAPublicKeyPEM(t *testing.T) {
 	}
 }
 
+func TestTLSECDSAX509SelfSignedCertificatePEM(t *testing.T) {
+	t.Parallel()
+
+	key := acctest.TLSECDSAPrivateKeyPEM(t, "P-384")
+	certificate := acctest.TLSECDSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	if !strings.Contains(certificate, acctest.PEMBlockTypeCertificate) {
+		t.Errorf("certificate does not contain CERTIFICATE: %s", certificate)
+	}
+}
+
 func TestTLSPEMEscapeNewlines(t *testing.T) {
 	t.Parallel()
 
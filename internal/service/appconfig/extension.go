@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -70,8 +71,9 @@ func ResourceExtension() *schema.Resource {
 										Required: true,
 									},
 									names.AttrRoleARN: {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
 									},
 									names.AttrURI: {
 										Type:     schema.TypeString,
@@ -121,7 +123,13 @@ func ResourceExtension() *schema.Resource {
 				Computed: true,
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Each update creates a new extension version.
+			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("action_point", names.AttrDescription, names.AttrParameter)
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -215,11 +223,11 @@ func resourceExtensionUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		out, err := conn.UpdateExtension(ctx, in)
 
 		if err != nil {
-			return create.AppendDiagError(diags, names.AppConfig, create.ErrActionWaitingForUpdate, ResExtension, d.Get(names.AttrName).(string), err)
+			return create.AppendDiagError(diags, names.AppConfig, create.ErrActionUpdating, ResExtension, d.Get(names.AttrName).(string), err)
 		}
 
 		if out == nil {
-			return create.AppendDiagError(diags, names.AppConfig, create.ErrActionWaitingForUpdate, ResExtension, d.Get(names.AttrName).(string), errors.New("No Extension returned with update request."))
+			return create.AppendDiagError(diags, names.AppConfig, create.ErrActionUpdating, ResExtension, d.Get(names.AttrName).(string), errors.New("No Extension returned with update request."))
 		}
 	}
 
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_athena_capacity_reservation", name="Capacity Reservation")
+// @Tags(identifierAttribute="arn")
+func newCapacityReservationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &capacityReservationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type capacityReservationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*capacityReservationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_athena_capacity_reservation"
+}
+
+func (r *capacityReservationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allocated_dpus": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_successful_allocation_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_dpus": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(24),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *capacityReservationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &athena.CreateCapacityReservationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateCapacityReservation(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, r.capacityReservationARN(ctx, name))
+	data.ID = fwflex.StringValueToFramework(ctx, name)
+
+	output, err := waitCapacityReservationActive(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) create", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *capacityReservationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	output, err := findCapacityReservationByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Athena Capacity Reservation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringValueToFramework(ctx, r.capacityReservationARN(ctx, data.ID.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *capacityReservationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new capacityReservationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := new.ID.ValueString()
+
+	waitCapacityReservation := waitCapacityReservationActive
+	if !old.TargetDPUs.Equal(new.TargetDPUs) {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(name),
+			TargetDpus: fwflex.Int32FromFramework(ctx, new.TargetDPUs),
+		}
+
+		_, err := conn.UpdateCapacityReservation(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Athena Capacity Reservation (%s)", name), err.Error())
+
+			return
+		}
+
+		waitCapacityReservation = waitCapacityReservationUpdated
+	}
+
+	// Computed attributes such as allocated DPUs and status are always refreshed.
+	output, err := waitCapacityReservation(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) update", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *capacityReservationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.ID.ValueString()
+
+	// A capacity reservation must be cancelled before it can be deleted.
+	if data.Status.ValueEnum() != awstypes.CapacityReservationStatusCancelled {
+		_, err := conn.CancelCapacityReservation(ctx, &athena.CancelCapacityReservationInput{
+			Name: aws.String(name),
+		})
+
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("cancelling Athena Capacity Reservation (%s)", name), err.Error())
+
+			return
+		}
+
+		if _, err := waitCapacityReservationCancelled(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) cancel", name), err.Error())
+
+			return
+		}
+	}
+
+	_, err := conn.DeleteCapacityReservation(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *capacityReservationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *capacityReservationResource) capacityReservationARN(ctx context.Context, name string) string {
+	return r.Meta().RegionalARN(ctx, "athena", "capacity-reservation/"+name)
+}
+
+type capacityReservationResourceModel struct {
+	AllocatedDPUs                types.Int64                                            `tfsdk:"allocated_dpus"`
+	ARN                          types.String                                           `tfsdk:"arn"`
+	CreationTime                 timetypes.RFC3339                                      `tfsdk:"creation_time"`
+	ID                           types.String                                           `tfsdk:"id"`
+	LastSuccessfulAllocationTime timetypes.RFC3339                                      `tfsdk:"last_successful_allocation_time"`
+	Name                         types.String                                           `tfsdk:"name"`
+	Status                       fwtypes.StringEnum[awstypes.CapacityReservationStatus] `tfsdk:"status"`
+	Tags                         tftags.Map                                             `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                             `tfsdk:"tags_all"`
+	TargetDPUs                   types.Int64                                            `tfsdk:"target_dpus"`
+	Timeouts                     timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func findCapacityReservationByName(ctx context.Context, conn *athena.Client, name string) (*awstypes.CapacityReservation, error) {
+	output, err := findCapacityReservation(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.CapacityReservationStatusCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: name,
+		}
+	}
+
+	return output, nil
+}
+
+func findCapacityReservation(ctx context.Context, conn *athena.Client, name string) (*awstypes.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservation(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func statusCapacityReservation(ctx context.Context, conn *athena.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		// Don't call findCapacityReservationByName as it maps CANCELLED to NotFoundError.
+		output, err := findCapacityReservation(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending),
+		Target:  enum.Slice(awstypes.CapacityReservationStatusActive),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationUpdated(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	// The reservation can still report ACTIVE with the previous allocation right after the update request.
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending),
+		Target:                    enum.Slice(awstypes.CapacityReservationStatusActive),
+		Refresh:                   statusCapacityReservation(ctx, conn, name),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationStatusActive, awstypes.CapacityReservationStatusCancelling, awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending),
+		Target:  enum.Slice(awstypes.CapacityReservationStatusCancelled),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
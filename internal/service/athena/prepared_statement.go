@@ -56,9 +56,12 @@ func resourcePreparedStatement() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_@:]{1,256}$`), ""),
 			},
 			"query_statement": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 262144),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 262144),
+					validation.StringIsNotWhiteSpace,
+				),
 			},
 			"workgroup": {
 				Type:         schema.TypeString,
@@ -135,17 +138,15 @@ func resourcePreparedStatementUpdate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// QueryStatement is required by UpdatePreparedStatement even if only the description changes.
 	input := &athena.UpdatePreparedStatementInput{
-		StatementName: aws.String(statementName),
-		WorkGroup:     aws.String(workGroupName),
-	}
-
-	if d.HasChanges(names.AttrDescription) {
-		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
 	}
 
-	if d.HasChanges("query_statement") {
-		input.QueryStatement = aws.String(d.Get("query_statement").(string))
+	if v, ok := d.GetOk(names.AttrDescription); ok || d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(v.(string))
 	}
 
 	_, err = conn.UpdatePreparedStatement(ctx, input)
@@ -172,12 +173,12 @@ func resourcePreparedStatementDelete(ctx context.Context, d *schema.ResourceData
 		WorkGroup:     aws.String(workGroupName),
 	})
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
+	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "is not found") {
 		return diags
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Athena Prepaed Statement (%s): %s", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Prepared Statement (%s): %s", d.Id(), err)
 	}
 
 	return diags
@@ -191,7 +192,8 @@ func findPreparedStatementByTwoPartKey(ctx context.Context, conn *athena.Client,
 
 	output, err := conn.GetPreparedStatement(ctx, input)
 
-	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "WorkGroup is not found") {
+	// The prepared statement is gone if its workgroup has been deleted.
+	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "is not found") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -79,6 +79,34 @@ func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAthenaPreparedStatement_disappears_WorkGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	resourceName := "aws_athena_prepared_statement.test"
+	workGroupResourceName := "aws_athena_workgroup.test"
+	condition := "x = ?"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, condition),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourceWorkGroup(), workGroupResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccAthenaPreparedStatement_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
@@ -118,6 +146,22 @@ func TestAccAthenaPreparedStatement_update(t *testing.T) {
 					acctest.CheckResourceAttrHasSuffix(resourceName, "query_statement", updatedCondition),
 				),
 			},
+			{
+				Config: testAccPreparedStatementConfig_update(rName, updatedCondition, "desc3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc3"),
+					acctest.CheckResourceAttrHasSuffix(resourceName, "query_statement", updatedCondition),
+				),
+			},
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, condition),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					acctest.CheckResourceAttrHasSuffix(resourceName, "query_statement", condition),
+				),
+			},
 		},
 	})
 }
@@ -171,7 +215,8 @@ resource "aws_s3_bucket" "test" {
 }
 
 resource "aws_athena_workgroup" "test" {
-  name = "%[2]s-%[1]s"
+  name          = "%[2]s-%[1]s"
+  force_destroy = true
 }
 
 resource "aws_athena_database" "test" {
@@ -969,6 +969,7 @@ func resourceGroup() *schema.Resource {
 			launchTemplateCustomDiff(names.AttrLaunchTemplate, "launch_template.0.name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.launch_template_specification.0.launch_template_name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.override"),
+			warmPoolCustomDiff,
 		),
 	}
 }
@@ -1037,6 +1038,27 @@ func launchTemplateCustomDiff(baseAttribute, subAttribute string) schema.Customi
 	}
 }
 
+func warmPoolCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("warm_pool.0.max_group_prepared_capacity") || !diff.NewValueKnown("warm_pool.0.min_size") {
+		return nil
+	}
+
+	v, ok := diff.Get("warm_pool").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap := v[0].(map[string]interface{})
+	maxGroupPreparedCapacity, minSize := tfMap["max_group_prepared_capacity"].(int), tfMap["min_size"].(int)
+
+	// The default of -1 means the group's maximum capacity is used.
+	if maxGroupPreparedCapacity != defaultWarmPoolMaxGroupPreparedCapacity && maxGroupPreparedCapacity < minSize {
+		return fmt.Errorf("warm_pool.0.max_group_prepared_capacity (%d) must be greater than or equal to warm_pool.0.min_size (%d)", maxGroupPreparedCapacity, minSize)
+	}
+
+	return nil
+}
+
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
@@ -1928,6 +1928,24 @@ func TestAccAutoScalingGroup_warmPool(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_WarmPool_invalidMaxGroupPreparedCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupConfig_warmPoolSizes(rName, 3, 2),
+				ExpectError: regexache.MustCompile(`max_group_prepared_capacity \(2\) must be greater than or equal to warm_pool.0.min_size \(3\)`),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_launchTempPartitionNum(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.AutoScalingGroup
@@ -5875,6 +5893,24 @@ resource "aws_autoscaling_group" "test" {
 `, rName))
 }
 
+func testAccGroupConfig_warmPoolSizes(rName string, minSize, maxGroupPreparedCapacity int) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  max_size             = 5
+  min_size             = 1
+  desired_capacity     = 1
+  name                 = %[1]q
+  launch_configuration = aws_launch_configuration.test.name
+
+  warm_pool {
+    min_size                    = %[2]d
+    max_group_prepared_capacity = %[3]d
+  }
+}
+`, rName, minSize, maxGroupPreparedCapacity))
+}
+
 func testAccGroupConfig_warmPoolZero(rName string) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
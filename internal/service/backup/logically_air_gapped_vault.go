@@ -87,6 +87,12 @@ func (r *logicallyAirGappedVaultResource) Schema(ctx context.Context, request re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"recovery_points": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -130,13 +136,17 @@ func (r *logicallyAirGappedVaultResource) Create(ctx context.Context, request re
 	data.BackupVaultARN = fwflex.StringToFramework(ctx, output.BackupVaultArn)
 	data.ID = fwflex.StringToFramework(ctx, output.BackupVaultName)
 
-	if _, err := waitLogicallyAirGappedVaultCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+	vault, err := waitLogicallyAirGappedVaultCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
 		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Backup Logically Air Gapped Vault (%s) create", data.ID.ValueString()), err.Error())
 
 		return
 	}
 
+	data.RecoveryPoints = types.Int64Value(vault.NumberOfRecoveryPoints)
+
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -170,6 +180,8 @@ func (r *logicallyAirGappedVaultResource) Read(ctx context.Context, request reso
 		return
 	}
 
+	data.RecoveryPoints = types.Int64Value(output.NumberOfRecoveryPoints)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -207,6 +219,7 @@ type logicallyAirGappedVaultResourceModel struct {
 	ID               types.String   `tfsdk:"id"`
 	MaxRetentionDays types.Int64    `tfsdk:"max_retention_days"`
 	MinRetentionDays types.Int64    `tfsdk:"min_retention_days"`
+	RecoveryPoints   types.Int64    `tfsdk:"recovery_points"`
 	Tags             tftags.Map     `tfsdk:"tags"`
 	TagsAll          tftags.Map     `tfsdk:"tags_all"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
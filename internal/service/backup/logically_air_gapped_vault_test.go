@@ -44,6 +44,7 @@ func TestAccBackupLogicallyAirGappedVault_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("max_retention_days"), knownvalue.Int64Exact(10)),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("min_retention_days"), knownvalue.Int64Exact(7)),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("recovery_points"), knownvalue.Int64Exact(0)),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTagsAll), knownvalue.MapExact(map[string]knownvalue.Check{})),
 				},
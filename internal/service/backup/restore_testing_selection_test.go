@@ -56,6 +56,48 @@ func TestAccBackupRestoreTestingSelection_basic(t *testing.T) {
 	})
 }
 
+func TestAccBackupRestoreTestingSelection_ebsWeekly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var restoretestingplan awstypes.RestoreTestingSelectionForGet
+	resourceName := "aws_backup_restore_testing_selection.test"
+	planResourceName := "aws_backup_restore_testing_plan.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_ebsWeekly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &restoretestingplan),
+					resource.TestCheckResourceAttr(planResourceName, names.AttrScheduleExpression, "cron(0 1 ? * SUN *)"),
+					resource.TestCheckResourceAttr(planResourceName, "start_window_hours", "24"),
+					resource.TestCheckResourceAttr(planResourceName, "recovery_point_selection.0.recovery_point_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(planResourceName, "recovery_point_selection.0.recovery_point_types.*", "SNAPSHOT"),
+					resource.TestCheckResourceAttrSet(planResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_type", "EBS"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validation_window_hours", "2"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        fmt.Sprintf("%s:%s", rName, rName+"_plan"),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
 func TestAccBackupRestoreTestingSelection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var restoretestingselection awstypes.RestoreTestingSelectionForGet
@@ -267,3 +309,57 @@ resource "aws_backup_restore_testing_selection" "test" {
 }
 `, rName))
 }
+
+func testAccRestoreTestingSelectionConfig_ebsWeekly(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Principal = {
+          Service = "backup.${data.aws_partition.current.dns_suffix}"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+}
+
+resource "aws_backup_restore_testing_plan" "test" {
+  name = "%[1]s_plan"
+
+  recovery_point_selection {
+    algorithm            = "RANDOM_WITHIN_WINDOW"
+    include_vaults       = ["*"]
+    recovery_point_types = ["SNAPSHOT"]
+  }
+
+  schedule_expression = "cron(0 1 ? * SUN *)" # Weekly on Sunday at 01:00
+  start_window_hours  = 24
+}
+
+resource "aws_backup_restore_testing_selection" "test" {
+  name = %[1]q
+
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  protected_resource_type   = "EBS"
+  iam_role_arn              = aws_iam_role.test.arn
+  validation_window_hours   = 2
+
+  protected_resource_arns = ["*"]
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
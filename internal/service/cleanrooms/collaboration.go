@@ -228,15 +228,15 @@ func resourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting data_encryption_metadata: %s", err)
 	}
 
-	membersOut, err := findMembersByCollaborationId(ctx, conn, d.Id())
+	members, err := findMembersByCollaborationId(ctx, conn, d.Id())
 	if err != nil {
 		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
 	}
 
-	if err := d.Set("member", flattenMembers(membersOut.MemberSummaries, collaboration.CreatorAccountId)); err != nil {
+	if err := d.Set("member", flattenMembers(members, collaboration.CreatorAccountId)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting member: %s", err)
 	}
-	if err := d.Set("creator_member_abilities", flattenCreatorAbilities(membersOut.MemberSummaries, collaboration.CreatorAccountId)); err != nil {
+	if err := d.Set("creator_member_abilities", flattenCreatorAbilities(members, collaboration.CreatorAccountId)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting creator_member_abilities: %s", err)
 	}
 
@@ -315,28 +315,35 @@ func findCollaborationByID(ctx context.Context, conn *cleanrooms.Client, id stri
 	return out, nil
 }
 
-func findMembersByCollaborationId(ctx context.Context, conn *cleanrooms.Client, id string) (*cleanrooms.ListMembersOutput, error) {
+func findMembersByCollaborationId(ctx context.Context, conn *cleanrooms.Client, id string) ([]types.MemberSummary, error) {
 	in := &cleanrooms.ListMembersInput{
 		CollaborationIdentifier: aws.String(id),
 	}
-	out, err := conn.ListMembers(ctx, in)
+	var output []types.MemberSummary
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: in,
+	pages := cleanrooms.NewListMembersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
 		}
-	}
 
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MemberSummaries...)
 	}
 
-	if out == nil || out.MemberSummaries == nil {
+	if len(output) == 0 {
 		return nil, tfresource.NewEmptyResultError(in)
 	}
 
-	return out, nil
+	return output, nil
 }
 
 func expandMemberAbilities(data []interface{}) []types.MemberAbility {
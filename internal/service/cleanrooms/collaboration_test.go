@@ -285,6 +285,47 @@ func TestAccCleanRoomsCollaboration_updateMemberAbilities(t *testing.T) {
 	})
 }
 
+func TestAccCleanRoomsCollaboration_multipleMembers(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var collaboration cleanrooms.GetCollaborationOutput
+	resourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCollaborationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationConfig_multipleMembers(TEST_NAME, TEST_DESCRIPTION, TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollaborationExists(ctx, resourceName, &collaboration),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						names.AttrAccountID:   acctest.Ct12Digit,
+						names.AttrDisplayName: "OtherMember",
+						names.AttrStatus:      "INVITED",
+						"member_abilities.#":  "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						names.AttrAccountID:   "210987654321",
+						names.AttrDisplayName: "ThirdMember",
+						names.AttrStatus:      "INVITED",
+						"member_abilities.#":  "0",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user"},
+			},
+		},
+	})
+}
+
 func testAccCheckCollaborationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
@@ -472,6 +513,19 @@ func testAccCollaborationConfig_additionalMember(rName string, description strin
 		TEST_CREATOR_DISPLAY_NAME, TEST_QUERY_LOG_STATUS, TEST_DATA_ENCRYPTION_SETTINGS, TEST_ADDITIONAL_MEMBER)
 }
 
+func testAccCollaborationConfig_multipleMembers(rName string, description string, tagValue string) string {
+	additionalMembers := TEST_ADDITIONAL_MEMBER + `
+member {
+  account_id       = 210987654321
+  display_name     = "ThirdMember"
+  member_abilities = []
+}
+`
+
+	return testAccCollaboration_configurable(rName, description, tagValue, TEST_MEMBER_ABILITIES,
+		TEST_CREATOR_DISPLAY_NAME, TEST_QUERY_LOG_STATUS, TEST_DATA_ENCRYPTION_SETTINGS, additionalMembers)
+}
+
 func testAccCollaborationConfig_swapMemberAbilities(rName string, description string, tagValue string) string {
 	additionalMember := `
 		member {
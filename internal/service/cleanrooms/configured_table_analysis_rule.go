@@ -0,0 +1,668 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDParts = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_analysis_rule",name="Configured Table Analysis Rule")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cleanrooms;cleanrooms.GetConfiguredTableAnalysisRuleOutput")
+func newResourceConfiguredTableAnalysisRule(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceConfiguredTableAnalysisRule{}
+
+	return r, nil
+}
+
+type resourceConfiguredTableAnalysisRule struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_table_analysis_rule"
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	additionalAnalysesAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.AdditionalAnalyses](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	allowedJoinOperatorsAttribute := schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringEnumType[awstypes.JoinOperator](),
+		ElementType: fwtypes.StringEnumType[awstypes.JoinOperator](),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_rule_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfiguredTableAnalysisRuleType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_rule_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRulePolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"v1": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRulePolicyV1Model](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"aggregation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleAggregationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("custom"),
+												path.MatchRelative().AtParent().AtName("list"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses":    additionalAnalysesAttribute,
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"dimension_columns": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"join_columns": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"join_required": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.JoinRequiredOption](),
+													Optional:   true,
+												},
+												"scalar_functions": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringEnumType[awstypes.ScalarFunctions](),
+													ElementType: fwtypes.StringEnumType[awstypes.ScalarFunctions](),
+													Required:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"aggregate_columns": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregateColumnModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_names": schema.ListAttribute{
+																CustomType:  fwtypes.ListOfStringType,
+																ElementType: types.StringType,
+																Required:    true,
+															},
+															"function": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregateFunctionName](),
+																Required:   true,
+															},
+														},
+													},
+												},
+												"output_constraints": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregationConstraintModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_name": schema.StringAttribute{
+																Required: true,
+															},
+															"minimum": schema.Int64Attribute{
+																Required: true,
+															},
+															names.AttrType: schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregationType](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"custom": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleCustomModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses": additionalAnalysesAttribute,
+												"allowed_analyses": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"allowed_analysis_providers": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"disallowed_output_columns": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+											},
+										},
+									},
+									"list": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleListModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses":    additionalAnalysesAttribute,
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"join_columns": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"list_columns": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateConfiguredTableAnalysisRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, data.ConfiguredTableID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating resource ID", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	output, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		ConfiguredTableIdentifier: plan.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateConfiguredTableAnalysisRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(plan.flatten(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Configured Table Analysis Rule", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceConfiguredTableAnalysisRuleData struct {
+	AnalysisRulePolicy fwtypes.ListNestedObjectValueOf[analysisRulePolicyModel]     `tfsdk:"analysis_rule_policy"`
+	AnalysisRuleType   fwtypes.StringEnum[awstypes.ConfiguredTableAnalysisRuleType] `tfsdk:"analysis_rule_type"`
+	ConfiguredTableID  types.String                                                 `tfsdk:"configured_table_id"`
+	CreateTime         timetypes.RFC3339                                            `tfsdk:"create_time"`
+	ID                 types.String                                                 `tfsdk:"id"`
+	UpdateTime         timetypes.RFC3339                                            `tfsdk:"update_time"`
+}
+
+func (m *resourceConfiguredTableAnalysisRuleData) InitFromID() error {
+	parts, err := intflex.ExpandResourceId(m.ID.ValueString(), configuredTableAnalysisRuleIDParts, false)
+	if err != nil {
+		return err
+	}
+
+	m.ConfiguredTableID = types.StringValue(parts[0])
+	m.AnalysisRuleType = fwtypes.StringEnumValue(awstypes.ConfiguredTableAnalysisRuleType(parts[1]))
+
+	return nil
+}
+
+func (m *resourceConfiguredTableAnalysisRuleData) setID() (string, error) {
+	parts := []string{
+		m.ConfiguredTableID.ValueString(),
+		m.AnalysisRuleType.ValueString(),
+	}
+
+	return intflex.FlattenResourceId(parts, configuredTableAnalysisRuleIDParts, false)
+}
+
+// flatten copies the API analysis rule into the model.
+// The API returns the policy as "Policy" rather than "AnalysisRulePolicy", so it is flattened explicitly.
+func (m *resourceConfiguredTableAnalysisRuleData) flatten(ctx context.Context, apiObject *awstypes.ConfiguredTableAnalysisRule) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, apiObject, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.AnalysisRuleType = fwtypes.StringEnumValue(apiObject.Type)
+
+	var policy analysisRulePolicyModel
+	diags.Append(policy.Flatten(ctx, apiObject.Policy)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.AnalysisRulePolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &policy)
+
+	return diags
+}
+
+var (
+	_ fwflex.Expander  = analysisRulePolicyModel{}
+	_ fwflex.Flattener = (*analysisRulePolicyModel)(nil)
+	_ fwflex.Expander  = analysisRulePolicyV1Model{}
+	_ fwflex.Flattener = (*analysisRulePolicyV1Model)(nil)
+)
+
+type analysisRulePolicyModel struct {
+	V1 fwtypes.ListNestedObjectValueOf[analysisRulePolicyV1Model] `tfsdk:"v1"`
+}
+
+func (m analysisRulePolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.V1.IsNull():
+		v1Data, d := m.V1.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyMemberV1
+		diags.Append(fwflex.Expand(ctx, v1Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *analysisRulePolicyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.ConfiguredTableAnalysisRulePolicyMemberV1:
+		var model analysisRulePolicyV1Model
+		diags.Append(model.Flatten(ctx, t.Value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.V1 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type analysisRulePolicyV1Model struct {
+	Aggregation fwtypes.ListNestedObjectValueOf[analysisRuleAggregationModel] `tfsdk:"aggregation"`
+	Custom      fwtypes.ListNestedObjectValueOf[analysisRuleCustomModel]      `tfsdk:"custom"`
+	List        fwtypes.ListNestedObjectValueOf[analysisRuleListModel]        `tfsdk:"list"`
+}
+
+func (m analysisRulePolicyV1Model) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Aggregation.IsNull():
+		aggregationData, d := m.Aggregation.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation
+		diags.Append(fwflex.Expand(ctx, aggregationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Custom.IsNull():
+		customData, d := m.Custom.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom
+		diags.Append(fwflex.Expand(ctx, customData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.List.IsNull():
+		listData, d := m.List.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList
+		diags.Append(fwflex.Expand(ctx, listData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *analysisRulePolicyV1Model) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.Aggregation = fwtypes.NewListNestedObjectValueOfNull[analysisRuleAggregationModel](ctx)
+	m.Custom = fwtypes.NewListNestedObjectValueOfNull[analysisRuleCustomModel](ctx)
+	m.List = fwtypes.NewListNestedObjectValueOfNull[analysisRuleListModel](ctx)
+
+	switch t := v.(type) {
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		var model analysisRuleAggregationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Aggregation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+		var model analysisRuleCustomModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Custom = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		var model analysisRuleListModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.List = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type analysisRuleAggregationModel struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]                   `tfsdk:"additional_analyses"`
+	AggregateColumns     fwtypes.ListNestedObjectValueOf[aggregateColumnModel]             `tfsdk:"aggregate_columns"`
+	AllowedJoinOperators fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.JoinOperator]]    `tfsdk:"allowed_join_operators"`
+	DimensionColumns     fwtypes.ListValueOf[types.String]                                 `tfsdk:"dimension_columns"`
+	JoinColumns          fwtypes.ListValueOf[types.String]                                 `tfsdk:"join_columns"`
+	JoinRequired         fwtypes.StringEnum[awstypes.JoinRequiredOption]                   `tfsdk:"join_required"`
+	OutputConstraints    fwtypes.ListNestedObjectValueOf[aggregationConstraintModel]       `tfsdk:"output_constraints"`
+	ScalarFunctions      fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.ScalarFunctions]] `tfsdk:"scalar_functions"`
+}
+
+type aggregateColumnModel struct {
+	ColumnNames fwtypes.ListValueOf[types.String]                  `tfsdk:"column_names"`
+	Function    fwtypes.StringEnum[awstypes.AggregateFunctionName] `tfsdk:"function"`
+}
+
+type aggregationConstraintModel struct {
+	ColumnName types.String                                 `tfsdk:"column_name"`
+	Minimum    types.Int64                                  `tfsdk:"minimum"`
+	Type       fwtypes.StringEnum[awstypes.AggregationType] `tfsdk:"type"`
+}
+
+type analysisRuleCustomModel struct {
+	AdditionalAnalyses       fwtypes.StringEnum[awstypes.AdditionalAnalyses] `tfsdk:"additional_analyses"`
+	AllowedAnalyses          fwtypes.ListValueOf[types.String]               `tfsdk:"allowed_analyses"`
+	AllowedAnalysisProviders fwtypes.ListValueOf[types.String]               `tfsdk:"allowed_analysis_providers"`
+	DisallowedOutputColumns  fwtypes.ListValueOf[types.String]               `tfsdk:"disallowed_output_columns"`
+}
+
+type analysisRuleListModel struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]                `tfsdk:"additional_analyses"`
+	AllowedJoinOperators fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.JoinOperator]] `tfsdk:"allowed_join_operators"`
+	JoinColumns          fwtypes.ListValueOf[types.String]                              `tfsdk:"join_columns"`
+	ListColumns          fwtypes.ListValueOf[types.String]                              `tfsdk:"list_columns"`
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType awstypes.ConfiguredTableAnalysisRuleType) (*cleanrooms.GetConfiguredTableAnalysisRuleOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -356,6 +356,58 @@ func TestAccCloudFormationStackInstances_deploymentTargets(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackInstances_DeploymentTargets_multiRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackInstances tfcloudformation.StackInstances
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckStackSet(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/stacksets.cloudformation.amazonaws.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationEndpointID, "organizations"),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackInstancesForOrganizationalUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackInstancesConfig_deploymentTargetsMultiRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesForOrganizationalUnitExists(ctx, resourceName, stackInstances),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.organizational_unit_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "retain_stacks", acctest.CtFalse),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "stack_instance_summaries.*", map[string]string{
+						names.AttrRegion: acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "stack_instance_summaries.*", map[string]string{
+						names.AttrRegion: acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_stacks",
+					"call_as",
+					"deployment_targets",
+					"regions",
+				},
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackInstances_DeploymentTargets_emptyOU(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackInstances tfcloudformation.StackInstances
@@ -1052,6 +1104,22 @@ resource "aws_cloudformation_stack_instances" "test" {
 `)
 }
 
+func testAccStackInstancesConfig_deploymentTargetsMultiRegion(rName string) string {
+	return acctest.ConfigCompose(testAccStackInstancesBaseConfig_ServiceManagedStackSet(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_instances" "test" {
+  stack_set_name = aws_cloudformation_stack_set.test.name
+  regions        = [%[1]q, %[2]q]
+  retain_stacks  = false
+
+  deployment_targets {
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+}
+`, acctest.Region(), acctest.AlternateRegion()))
+}
+
 func testAccStackInstancesConfig_DeploymentTargets_emptyOU(rName string) string {
 	return acctest.ConfigCompose(testAccStackInstancesBaseConfig_ServiceManagedStackSet(rName), fmt.Sprintf(`
 resource "aws_organizations_organizational_unit" "test" {
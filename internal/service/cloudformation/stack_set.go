@@ -65,11 +65,11 @@ func resourceStackSet() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 1,
 				Optional: true,
-				ForceNew: true,
 				ConflictsWith: []string{
 					"administration_role_arn",
 					"execution_role_name",
 				},
+				DiffSuppressFunc: suppressRemovedDisabledAutoDeployment,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrEnabled: {
@@ -449,6 +449,12 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.AdministrationRoleARN = nil
 		input.ExecutionRoleName = nil
 		input.AutoDeployment = expandAutoDeployment(v.([]interface{}))
+	} else if d.HasChange("auto_deployment") {
+		// Removing the block must explicitly disable automatic deployments,
+		// otherwise the previous configuration is retained.
+		input.AutoDeployment = &awstypes.AutoDeployment{
+			Enabled: aws.Bool(false),
+		}
 	}
 
 	output, err := conn.UpdateStackSet(ctx, input)
@@ -680,6 +686,11 @@ func stackSetOperationError(apiObjects []awstypes.StackSetOperationResultSummary
 	var errs []error
 
 	for _, apiObject := range apiObjects {
+		// Only report the stack instances that failed; others may have succeeded within the failure tolerance.
+		if apiObject.Status == awstypes.StackSetOperationResultStatusSucceeded {
+			continue
+		}
+
 		errs = append(errs, fmt.Errorf("Account (%s), Region (%s), %s: %s",
 			aws.ToString(apiObject.Account),
 			aws.ToString(apiObject.Region),
@@ -691,6 +702,18 @@ func stackSetOperationError(apiObjects []awstypes.StackSetOperationResultSummary
 	return errors.Join(errs...)
 }
 
+// suppressRemovedDisabledAutoDeployment suppresses the removal of an `auto_deployment` block
+// once automatic deployments have been disabled, as the API continues to report the setting.
+func suppressRemovedDisabledAutoDeployment(k, old, new string, d *schema.ResourceData) bool {
+	if k != "auto_deployment.#" || old != "1" || new != "0" {
+		return false
+	}
+
+	o, _ := d.GetChange("auto_deployment.0.enabled")
+
+	return !o.(bool)
+}
+
 func expandAutoDeployment(l []interface{}) *awstypes.AutoDeployment {
 	if len(l) == 0 {
 		return nil
@@ -292,7 +292,7 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	d.Set("include_linked_accounts_metrics", output.IncludeLinkedAccountsMetrics)
-	d.Set("last_update_date", output.CreationDate.Format(time.RFC3339))
+	d.Set("last_update_date", output.LastUpdateDate.Format(time.RFC3339))
 	d.Set(names.AttrName, output.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(output.Name)))
 	d.Set("output_format", output.OutputFormat)
@@ -239,6 +239,24 @@ func TestAccCloudWatchMetricStream_excludeFilters(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_includeAndExcludeFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_includeAndExcludeFilters(rName),
+				ExpectError: regexache.MustCompile(`"include_filter": conflicts with exclude_filter`),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_excludeFiltersWithMetricNames(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
@@ -726,6 +744,29 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName)
 }
 
+func testAccMetricStreamConfig_includeAndExcludeFilters(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = "json"
+
+  include_filter {
+    namespace = "AWS/EC2"
+  }
+
+  exclude_filter {
+    namespace = "AWS/EBS"
+  }
+}
+`, rName)
+}
+
 func testAccMetricStreamConfig_excludeFiltersWithMetricNames(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
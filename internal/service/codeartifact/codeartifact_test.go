@@ -32,6 +32,12 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain":   testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent":   testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageGroup": {
+			acctest.CtBasic:       testAccPackageGroup_basic,
+			acctest.CtDisappears:  testAccPackageGroup_disappears,
+			"originConfiguration": testAccPackageGroup_originConfiguration,
+			"tags":                testAccPackageGroup_tags,
+		},
 		"Repository": {
 			acctest.CtBasic:      testAccRepository_basic,
 			"description":        testAccRepository_description,
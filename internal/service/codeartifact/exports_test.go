@@ -7,11 +7,13 @@ package codeartifact
 var (
 	ResourceDomain                      = resourceDomain
 	ResourceDomainPermissionsPolicy     = resourceDomainPermissionsPolicy
+	ResourcePackageGroup                = resourcePackageGroup
 	ResourceRepository                  = resourceRepository
 	ResourceRepositoryPermissionsPolicy = resourceRepositoryPermissionsPolicy
 
 	FindDomainByTwoPartKey                        = findDomainByTwoPartKey
 	FindDomainPermissionsPolicyByTwoPartKey       = findDomainPermissionsPolicyByTwoPartKey
+	FindPackageGroupByThreePartKey                = findPackageGroupByThreePartKey
 	FindRepositoryByThreePartKey                  = findRepositoryByThreePartKey
 	FindRepositoryPermissionsPolicyByThreePartKey = findRepositoryPermissionsPolicyByThreePartKey
 )
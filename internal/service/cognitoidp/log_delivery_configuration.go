@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cognito_log_delivery_configuration", name="Log Delivery Configuration")
+func resourceLogDeliveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogDeliveryConfigurationPut,
+		ReadWithoutTimeout:   resourceLogDeliveryConfigurationRead,
+		UpdateWithoutTimeout: resourceLogDeliveryConfigurationPut,
+		DeleteWithoutTimeout: resourceLogDeliveryConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_configurations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"event_source": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EventSourceName](),
+						},
+						"firehose_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"log_level": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LogLevel](),
+						},
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrUserPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: resourceLogDeliveryConfigurationCustomizeDiff,
+	}
+}
+
+func resourceLogDeliveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	userPoolID := d.Get(names.AttrUserPoolID).(string)
+	input := &cognitoidentityprovider.SetLogDeliveryConfigurationInput{
+		LogConfigurations: expandLogConfigurationTypes(d.Get("log_configurations").([]interface{})),
+		UserPoolId:        aws.String(userPoolID),
+	}
+
+	_, err := conn.SetLogDeliveryConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Cognito Log Delivery Configuration (%s): %s", userPoolID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(userPoolID)
+	}
+
+	return append(diags, resourceLogDeliveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceLogDeliveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	logDeliveryConfig, err := findLogDeliveryConfigurationByUserPoolID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Log Delivery Configuration %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Log Delivery Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("log_configurations", flattenLogConfigurationTypes(logDeliveryConfig.LogConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_configurations: %s", err)
+	}
+	d.Set(names.AttrUserPoolID, logDeliveryConfig.UserPoolId)
+
+	return diags
+}
+
+func resourceLogDeliveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Cognito Log Delivery Configuration: %s", d.Id())
+	_, err := conn.SetLogDeliveryConfiguration(ctx, &cognitoidentityprovider.SetLogDeliveryConfigurationInput{
+		LogConfigurations: []awstypes.LogConfigurationType{},
+		UserPoolId:        aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Cognito Log Delivery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceLogDeliveryConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("log_configurations") {
+		return nil
+	}
+
+	eventSources := make(map[string]bool)
+
+	for i, tfMapRaw := range diff.Get("log_configurations").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := fmt.Sprintf("log_configurations.%d", i)
+		eventSource, logLevel := tfMap["event_source"].(string), tfMap["log_level"].(string)
+
+		if eventSource == "" || logLevel == "" {
+			// Values not yet known.
+			continue
+		}
+
+		if eventSources[eventSource] {
+			return fmt.Errorf("%s: event_source %q must only be configured once", key, eventSource)
+		}
+		eventSources[eventSource] = true
+
+		var configured []string
+		for _, v := range []string{"cloudwatch_logs_configuration", "firehose_configuration", "s3_configuration"} {
+			if tfList, ok := tfMap[v].([]interface{}); ok && len(tfList) > 0 {
+				configured = append(configured, v)
+			}
+		}
+
+		if len(configured) != 1 {
+			return fmt.Errorf("%s: exactly one of cloudwatch_logs_configuration, firehose_configuration or s3_configuration must be configured", key)
+		}
+
+		switch awstypes.EventSourceName(eventSource) {
+		case awstypes.EventSourceNameUserNotification:
+			if logLevel != string(awstypes.LogLevelError) {
+				return fmt.Errorf("%s: event_source %q requires log_level %q", key, eventSource, awstypes.LogLevelError)
+			}
+			if configured[0] != "cloudwatch_logs_configuration" {
+				return fmt.Errorf("%s: event_source %q only supports cloudwatch_logs_configuration", key, eventSource)
+			}
+		case awstypes.EventSourceNameUserAuthEvents:
+			if logLevel != string(awstypes.LogLevelInfo) {
+				return fmt.Errorf("%s: event_source %q requires log_level %q", key, eventSource, awstypes.LogLevelInfo)
+			}
+		}
+	}
+
+	return nil
+}
+
+func findLogDeliveryConfigurationByUserPoolID(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID string) (*awstypes.LogDeliveryConfigurationType, error) {
+	input := &cognitoidentityprovider.GetLogDeliveryConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.GetLogDeliveryConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LogDeliveryConfiguration == nil || len(output.LogDeliveryConfiguration.LogConfigurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LogDeliveryConfiguration, nil
+}
+
+func expandLogConfigurationTypes(tfList []interface{}) []awstypes.LogConfigurationType {
+	apiObjects := make([]awstypes.LogConfigurationType, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LogConfigurationType{
+			EventSource: awstypes.EventSourceName(tfMap["event_source"].(string)),
+			LogLevel:    awstypes.LogLevel(tfMap["log_level"].(string)),
+		}
+
+		if v, ok := tfMap["cloudwatch_logs_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CloudWatchLogsConfiguration = &awstypes.CloudWatchLogsConfigurationType{
+				LogGroupArn: aws.String(v[0].(map[string]interface{})["log_group_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["firehose_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FirehoseConfiguration = &awstypes.FirehoseConfigurationType{
+				StreamArn: aws.String(v[0].(map[string]interface{})["stream_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3Configuration = &awstypes.S3ConfigurationType{
+				BucketArn: aws.String(v[0].(map[string]interface{})["bucket_arn"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLogConfigurationTypes(apiObjects []awstypes.LogConfigurationType) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"event_source": string(apiObject.EventSource),
+			"log_level":    string(apiObject.LogLevel),
+		}
+
+		if v := apiObject.CloudWatchLogsConfiguration; v != nil {
+			tfMap["cloudwatch_logs_configuration"] = []interface{}{map[string]interface{}{
+				"log_group_arn": aws.ToString(v.LogGroupArn),
+			}}
+		}
+
+		if v := apiObject.FirehoseConfiguration; v != nil {
+			tfMap["firehose_configuration"] = []interface{}{map[string]interface{}{
+				"stream_arn": aws.ToString(v.StreamArn),
+			}}
+		}
+
+		if v := apiObject.S3Configuration; v != nil {
+			tfMap["s3_configuration"] = []interface{}{map[string]interface{}{
+				"bucket_arn": aws.ToString(v.BucketArn),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -81,6 +81,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cognito_identity_provider",
 			Name:     "Identity Provider",
 		},
+		{
+			Factory:  resourceLogDeliveryConfiguration,
+			TypeName: "aws_cognito_log_delivery_configuration",
+			Name:     "Log Delivery Configuration",
+		},
 		{
 			Factory:  resourceResourceServer,
 			TypeName: "aws_cognito_resource_server",
@@ -328,6 +328,14 @@ func TestAccCognitoIDPUserPoolClient_refreshTokenValidity_error(t *testing.T) {
 				Config:      testAccUserPoolClientConfig_refreshTokenValidityUnit(rName, 59, string(awstypes.TimeUnitsTypeMinutes)),
 				ExpectError: regexache.MustCompile(`Attribute refresh_token_validity must have a duration between 1h0m0s and\s+87600h0m0s,\s+got: 59m0s`),
 			},
+			{
+				Config:      testAccUserPoolClientConfig_refreshTokenValidityUnit(rName, 3599, string(awstypes.TimeUnitsTypeSeconds)),
+				ExpectError: regexache.MustCompile(`Attribute refresh_token_validity must have a duration between 1h0m0s and\s+87600h0m0s,\s+got: 59m59s`),
+			},
+			{
+				Config:      testAccUserPoolClientConfig_refreshTokenValidityUnit(rName, 87601, string(awstypes.TimeUnitsTypeHours)),
+				ExpectError: regexache.MustCompile(`Attribute refresh_token_validity must have a duration between 1h0m0s and\s+87600h0m0s,\s+got: 87601h0m0s`),
+			},
 		},
 	})
 }
@@ -46,6 +46,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"S3Template":                testAccConformancePack_S3Template,
 			"S3TemplateAndTemplateBody": testAccConformancePack_S3TemplateAndTemplateBody,
 			"updateInputParameters":     testAccConformancePack_updateInputParameters,
+			"undeclaredInputParameter":  testAccConformancePack_undeclaredInputParameter,
 			"updateS3Delivery":          testAccConformancePack_updateS3Delivery,
 			"updateS3Template":          testAccConformancePack_updateS3Template,
 			"updateTemplateBody":        testAccConformancePack_updateTemplateBody,
@@ -5,6 +5,7 @@ package configservice
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -39,14 +40,7 @@ func resourceConfigurationAggregator() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
-			// This is to prevent this error:
-			// All fields are ForceNew or Computed w/out Optional, Update is superfluous
-			customdiff.ForceNewIfChange("account_aggregation_source", func(_ context.Context, old, new, meta interface{}) bool {
-				return len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0
-			}),
-			customdiff.ForceNewIfChange("organization_aggregation_source", func(_ context.Context, old, new, meta interface{}) bool {
-				return len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0
-			}),
+			resourceConfigurationAggregatorCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -208,6 +202,31 @@ func resourceConfigurationAggregatorDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
+func resourceConfigurationAggregatorCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"account_aggregation_source", "organization_aggregation_source"} {
+		if v, ok := diff.GetOk(key); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			continue
+		}
+
+		if !diff.NewValueKnown(key+".0.all_regions") || !diff.NewValueKnown(key+".0.regions") {
+			continue
+		}
+
+		allRegions := diff.Get(key + ".0.all_regions").(bool)
+		regions := diff.Get(key + ".0.regions").([]interface{})
+
+		if allRegions && len(regions) > 0 {
+			return fmt.Errorf("%s: regions must not be set when all_regions is true", key)
+		}
+
+		if !allRegions && len(regions) == 0 {
+			return fmt.Errorf("%s: one of all_regions or regions must be set", key)
+		}
+	}
+
+	return nil
+}
+
 func findConfigurationAggregatorByName(ctx context.Context, conn *configservice.Client, name string) (*types.ConfigurationAggregator, error) {
 	input := &configservice.DescribeConfigurationAggregatorsInput{
 		ConfigurationAggregatorNames: []string{name},
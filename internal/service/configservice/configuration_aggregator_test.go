@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -89,6 +90,7 @@ func TestAccConfigServiceConfigurationAggregator_organization(t *testing.T) {
 
 func TestAccConfigServiceConfigurationAggregator_switch(t *testing.T) {
 	ctx := acctest.Context(t)
+	var ca types.ConfigurationAggregator
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_configuration_aggregator.test"
 
@@ -101,17 +103,59 @@ func TestAccConfigServiceConfigurationAggregator_switch(t *testing.T) {
 			{
 				Config: testAccConfigurationAggregatorConfig_account(rName),
 				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationAggregatorExists(ctx, resourceName, &ca),
 					resource.TestCheckResourceAttr(resourceName, "account_aggregation_source.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "organization_aggregation_source.#", "0"),
 				),
 			},
 			{
 				Config: testAccConfigurationAggregatorConfig_organization(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationAggregatorExists(ctx, resourceName, &ca),
 					resource.TestCheckResourceAttr(resourceName, "account_aggregation_source.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "organization_aggregation_source.#", "1"),
 				),
 			},
+			{
+				Config: testAccConfigurationAggregatorConfig_account(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationAggregatorExists(ctx, resourceName, &ca),
+					resource.TestCheckResourceAttr(resourceName, "account_aggregation_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_aggregation_source.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigServiceConfigurationAggregator_invalidRegions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationAggregatorConfig_accountRegions(rName, true, true),
+				ExpectError: regexache.MustCompile(`regions must not be set when all_regions is true`),
+			},
+			{
+				Config:      testAccConfigurationAggregatorConfig_accountRegions(rName, false, false),
+				ExpectError: regexache.MustCompile(`one of all_regions or regions must be set`),
+			},
 		},
 	})
 }
@@ -250,6 +294,24 @@ resource "aws_config_configuration_aggregator" "test" {
 `, rName)
 }
 
+func testAccConfigurationAggregatorConfig_accountRegions(rName string, allRegions, regions bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_config_configuration_aggregator" "test" {
+  name = %[1]q
+
+  account_aggregation_source {
+    account_ids = [data.aws_caller_identity.current.account_id]
+    all_regions = %[2]t
+    regions     = %[3]t ? [data.aws_region.current.name] : null
+  }
+}
+`, rName, allRegions, regions)
+}
+
 func testAccConfigurationAggregatorConfig_organization(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
@@ -6,6 +6,7 @@ package configservice
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -38,6 +40,8 @@ func resourceConformancePack() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceConformancePackCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -208,6 +212,63 @@ func resourceConformancePackDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceConformancePackCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Parameter names can only be validated against an inline template.
+	if !diff.NewValueKnown("template_body") || !diff.NewValueKnown("input_parameter") {
+		return nil
+	}
+
+	body := diff.Get("template_body").(string)
+	if body == "" {
+		return nil
+	}
+
+	declared, err := conformancePackTemplateParameterNames(body)
+	if err != nil {
+		// Malformed templates are reported by the template_body validation.
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("input_parameter").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["parameter_name"].(string); ok && v != "" {
+			if _, ok := declared[v]; !ok {
+				return fmt.Errorf("input_parameter %q is not declared in the Parameters section of template_body", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// conformancePackTemplateParameterNames returns the names of the parameters declared in a JSON or YAML conformance pack template.
+func conformancePackTemplateParameterNames(body string) (map[string]struct{}, error) {
+	body, err := verify.NormalizeJSONOrYAMLString(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so the normalized template can always be decoded as YAML.
+	var template struct {
+		Parameters map[string]interface{} `yaml:"Parameters"`
+	}
+
+	if err := yaml.DecodeFromString(body, &template); err != nil {
+		return nil, err
+	}
+
+	parameterNames := make(map[string]struct{}, len(template.Parameters))
+	for k := range template.Parameters {
+		parameterNames[k] = struct{}{}
+	}
+
+	return parameterNames, nil
+}
+
 func findConformancePackByName(ctx context.Context, conn *configservice.Client, name string) (*types.ConformancePackDetail, error) {
 	input := &configservice.DescribeConformancePacksInput{
 		ConformancePackNames: []string{name},
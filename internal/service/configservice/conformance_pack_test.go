@@ -278,6 +278,24 @@ func testAccConformancePack_updateInputParameters(t *testing.T) {
 	})
 }
 
+func testAccConformancePack_undeclaredInputParameter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConformancePackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConformancePackConfig_undeclaredInputParameter(rName),
+				ExpectError: regexache.MustCompile(`input_parameter "TestKey2" is not declared`),
+			},
+		},
+	})
+}
+
 func testAccConformancePack_updateS3Delivery(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pack types.ConformancePackDetail
@@ -634,6 +652,40 @@ EOT
 `, rName, pName1, pName2))
 }
 
+func testAccConformancePackConfig_undeclaredInputParameter(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test]
+  name       = %[1]q
+
+  input_parameter {
+    parameter_name  = "TestKey1"
+    parameter_value = "TestValue1"
+  }
+
+  input_parameter {
+    parameter_name  = "TestKey2"
+    parameter_value = "TestValue2"
+  }
+
+  template_body = <<EOT
+Parameters:
+  TestKey1:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
 func testAccConformancePackConfig_s3Delivery(rName, bucketName string) string {
 	return acctest.ConfigCompose(testAccConformancePackConfig_base(rName),
 		fmt.Sprintf(`
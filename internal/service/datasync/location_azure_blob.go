@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -110,10 +111,24 @@ func resourceLocationAzureBlob() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceLocationAzureBlobCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceLocationAzureBlobCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A SAS token must be supplied when authenticating with a shared access signature.
+	if authenticationType := awstypes.AzureBlobAuthenticationType(d.Get("authentication_type").(string)); authenticationType == awstypes.AzureBlobAuthenticationTypeSas {
+		if v := d.GetRawConfig().GetAttr("sas_configuration"); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+			return fmt.Errorf(`"sas_configuration" is required when "authentication_type" is %q`, authenticationType)
+		}
+	}
+
+	return nil
+}
+
 func resourceLocationAzureBlobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
@@ -59,6 +59,24 @@ func TestAccDataSyncLocationAzureBlob_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncLocationAzureBlob_sasConfigurationRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocationAzureBlobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLocationAzureBlobConfig_noSASConfiguration(rName),
+				ExpectError: regexache.MustCompile(`"sas_configuration" is required when "authentication_type" is "SAS"`),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncLocationAzureBlob_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v datasync.DescribeLocationAzureBlobOutput
@@ -251,6 +269,16 @@ resource "aws_datasync_location_azure_blob" "test" {
 `)
 }
 
+func testAccLocationAzureBlobConfig_noSASConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccLocationAzureBlobConfig_base(rName), `
+resource "aws_datasync_location_azure_blob" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SAS"
+  container_url       = "https://myaccount.blob.core.windows.net/mycontainer"
+}
+`)
+}
+
 func testAccLocationAzureBlobConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(testAccLocationAzureBlobConfig_base(rName), fmt.Sprintf(`
 resource "aws_datasync_location_azure_blob" "test" {
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -224,6 +225,13 @@ func resourceTask() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.TaskMode](),
+			},
 			"task_report_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -302,10 +310,41 @@ func resourceTask() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceTaskCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceTaskCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Enhanced mode tasks can only transfer between Amazon S3 locations.
+	if diff.Id() != "" || awstypes.TaskMode(diff.Get("task_mode").(string)) != awstypes.TaskModeEnhanced {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
+
+	for _, key := range []string{"source_location_arn", "destination_location_arn"} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		arn := diff.Get(key).(string)
+		_, err := findLocationS3ByARN(ctx, conn, arn)
+
+		if errs.IsA[*awstypes.InvalidRequestException](err) {
+			return fmt.Errorf("%s (%s) is not an Amazon S3 location; task_mode %q only supports Amazon S3 locations", key, arn, awstypes.TaskModeEnhanced)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading DataSync Location (%s): %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
@@ -333,6 +372,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("task_mode"); ok {
+		input.TaskMode = awstypes.TaskMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("task_report_config"); ok {
 		input.TaskReportConfig = expandTaskReportConfig(v.([]interface{}))
 	}
@@ -388,6 +431,7 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set(names.AttrSchedule, flattenTaskSchedule(output.Schedule)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
 	}
+	d.Set("task_mode", output.TaskMode)
 	if err := d.Set("task_report_config", flattenTaskReportConfig(output.TaskReportConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting task_report_config: %s", err)
 	}
@@ -308,6 +308,10 @@ func resourceTable() *schema.Resource {
 							Required: true,
 							// update is equivalent of force a new *replica*, not table
 						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						names.AttrStreamARN: {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -1430,7 +1434,7 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			return fmt.Errorf("creating replica (%s): %w", tfMap["region_name"].(string), err)
 		}
 
-		if _, err := waitReplicaActive(ctx, conn, tableName, tfMap["region_name"].(string), replicaDelayDefault, timeout); err != nil {
+		if _, err := waitReplicaActive(ctx, conn, tableName, tfMap["region_name"].(string), timeout, replicaDelayDefault); err != nil {
 			return fmt.Errorf("waiting for replica (%s) creation: %w", tfMap["region_name"].(string), err)
 		}
 
@@ -2216,6 +2220,8 @@ func flattenReplicaDescription(apiObject *awstypes.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.ToString(apiObject.RegionName)
 	}
 
+	tfMap[names.AttrStatus] = apiObject.ReplicaStatus
+
 	return tfMap
 }
 
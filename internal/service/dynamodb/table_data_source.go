@@ -155,6 +155,10 @@ func dataSourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
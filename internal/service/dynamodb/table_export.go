@@ -5,6 +5,8 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -40,6 +42,8 @@ func resourceTableExport() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: resourceTableExportCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -71,6 +75,44 @@ func resourceTableExport() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidUTCTimestamp,
 			},
+			"export_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ExportType](),
+			},
+			"incremental_export_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"export_from_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     verify.ValidUTCTimestamp,
+							DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+						},
+						"export_to_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     verify.ValidUTCTimestamp,
+							DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+						},
+						"export_view_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ExportViewType](),
+						},
+					},
+				},
+			},
 			"item_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -145,6 +187,14 @@ func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ExportTime = aws.Time(v)
 	}
 
+	if v, ok := d.GetOk("export_type"); ok {
+		input.ExportType = awstypes.ExportType(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_export_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalExportSpecification = expandIncrementalExportSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("s3_bucket_owner"); ok {
 		input.S3BucketOwner = aws.String(v.(string))
 	}
@@ -202,6 +252,10 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta i
 	if desc.ExportTime != nil {
 		d.Set("export_time", aws.ToTime(desc.ExportTime).Format(time.RFC3339))
 	}
+	d.Set("export_type", desc.ExportType)
+	if err := d.Set("incremental_export_specification", flattenIncrementalExportSpecification(desc.IncrementalExportSpecification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting incremental_export_specification: %s", err)
+	}
 	d.Set("item_count", desc.ItemCount)
 	d.Set("manifest_files_s3_key", desc.ExportManifest)
 	d.Set(names.AttrS3Bucket, desc.S3Bucket)
@@ -217,6 +271,65 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceTableExportCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		minIncrementalExportPeriod = 15 * time.Minute
+		maxIncrementalExportPeriod = 24 * time.Hour
+	)
+
+	// Only validate the configuration; "export_type" is computed as FULL_EXPORT when not configured.
+	v := d.GetRawConfig().GetAttr("export_type")
+	if !v.IsKnown() || !d.NewValueKnown("incremental_export_specification") {
+		return nil
+	}
+
+	exportType := awstypes.ExportTypeFullExport
+	if !v.IsNull() {
+		exportType = awstypes.ExportType(v.AsString())
+	}
+	spec := d.Get("incremental_export_specification").([]interface{})
+
+	if exportType != awstypes.ExportTypeIncrementalExport {
+		if len(spec) > 0 {
+			return fmt.Errorf(`"incremental_export_specification" can only be set when "export_type" is %q`, awstypes.ExportTypeIncrementalExport)
+		}
+
+		return nil
+	}
+
+	if len(spec) == 0 || spec[0] == nil {
+		return fmt.Errorf(`"incremental_export_specification" is required when "export_type" is %q`, awstypes.ExportTypeIncrementalExport)
+	}
+
+	if v, ok := d.GetOk("export_time"); ok && v.(string) != "" && d.Id() == "" {
+		return fmt.Errorf(`"export_time" cannot be set when "export_type" is %q`, awstypes.ExportTypeIncrementalExport)
+	}
+
+	if !d.NewValueKnown("incremental_export_specification.0.export_from_time") || !d.NewValueKnown("incremental_export_specification.0.export_to_time") {
+		return nil
+	}
+
+	tfMap := spec[0].(map[string]interface{})
+	from, to := tfMap["export_from_time"].(string), tfMap["export_to_time"].(string)
+
+	if from == "" || to == "" {
+		return errors.New(`"incremental_export_specification.0.export_from_time" and "incremental_export_specification.0.export_to_time" are required for incremental exports`)
+	}
+
+	fromTime, _ := time.Parse(time.RFC3339, from)
+	toTime, _ := time.Parse(time.RFC3339, to)
+
+	if !fromTime.Before(toTime) {
+		return fmt.Errorf(`"incremental_export_specification.0.export_from_time" (%s) must be before "incremental_export_specification.0.export_to_time" (%s)`, from, to)
+	}
+
+	if period := toTime.Sub(fromTime); period < minIncrementalExportPeriod || period > maxIncrementalExportPeriod {
+		return fmt.Errorf("incremental export period (%s) must be between %s and %s", period, minIncrementalExportPeriod, maxIncrementalExportPeriod)
+	}
+
+	return nil
+}
+
 func findTableExportByARN(ctx context.Context, conn *dynamodb.Client, arn string) (*awstypes.ExportDescription, error) {
 	input := &dynamodb.DescribeExportInput{
 		ExportArn: aws.String(arn),
@@ -273,3 +386,47 @@ func waitTableExportCreated(ctx context.Context, conn *dynamodb.Client, id strin
 
 	return nil, err
 }
+
+func expandIncrementalExportSpecification(tfMap map[string]interface{}) *awstypes.IncrementalExportSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IncrementalExportSpecification{}
+
+	if v, ok := tfMap["export_from_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportFromTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_to_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportToTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_view_type"].(string); ok && v != "" {
+		apiObject.ExportViewType = awstypes.ExportViewType(v)
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalExportSpecification(apiObject *awstypes.IncrementalExportSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"export_view_type": string(apiObject.ExportViewType),
+	}
+
+	if v := apiObject.ExportFromTime; v != nil {
+		tfMap["export_from_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ExportToTime; v != nil {
+		tfMap["export_to_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -173,6 +174,78 @@ func TestAccDynamoDBTableExport_s3Prefix(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTableExport_incremental(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var tableexport awstypes.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	// The export window must lie within the table's point-in-time recovery window and span at least 15 minutes.
+	exportFromTime := time.Now().UTC().Add(5 * time.Minute).Truncate(time.Minute)
+	exportToTime := exportFromTime.Add(15 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DynamoDB)
+			testAccPreCheckTableExport(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_baseConfig(rName),
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(time.Until(exportToTime.Add(time.Minute)))
+				},
+				Config: testAccTableExportConfig_incremental(rName, exportFromTime.Format(time.RFC3339), exportToTime.Format(time.RFC3339)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExportExists(ctx, resourceName, &tableexport),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "export_type", "INCREMENTAL_EXPORT"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.0.export_from_time", exportFromTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.0.export_to_time", exportToTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.0.export_view_type", "NEW_AND_OLD_IMAGES"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_incrementalInvalidPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableExportConfig_incremental(rName, "2024-01-01T01:00:00Z", "2024-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`must be before`),
+			},
+			{
+				Config:      testAccTableExportConfig_incremental(rName, "2024-01-01T00:00:00Z", "2024-01-01T00:05:00Z"),
+				ExpectError: regexache.MustCompile(`incremental export period \(5m0s\) must be between 15m0s and 24h0m0s`),
+			},
+		},
+	})
+}
+
 func testAccCheckTableExportExists(ctx context.Context, n string, v *awstypes.ExportDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -266,3 +339,19 @@ resource "aws_dynamodb_table_export" "test" {
   table_arn        = aws_dynamodb_table.test.arn
 }`, s3BucketPrefix))
 }
+
+func testAccTableExportConfig_incremental(tableName, exportFromTime, exportToTime string) string {
+	return acctest.ConfigCompose(testAccTableExportConfig_baseConfig(tableName), fmt.Sprintf(`
+resource "aws_dynamodb_table_export" "test" {
+  export_type = "INCREMENTAL_EXPORT"
+  s3_bucket   = aws_s3_bucket.test.id
+  table_arn   = aws_dynamodb_table.test.arn
+
+  incremental_export_specification {
+    export_from_time = %[1]q
+    export_to_time   = %[2]q
+    export_view_type = "NEW_AND_OLD_IMAGES"
+  }
+}
+`, exportFromTime, exportToTime))
+}
@@ -1997,6 +1997,10 @@ func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]*regexp.Regexp{
 						names.AttrARN: regexache.MustCompile(fmt.Sprintf(`:dynamodb:%s:`, acctest.AlternateRegion())),
 					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"region_name":    acctest.AlternateRegion(),
+						names.AttrStatus: string(awstypes.ReplicaStatusActive),
+					}),
 				),
 			},
 			{
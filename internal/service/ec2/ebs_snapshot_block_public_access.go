@@ -43,14 +43,24 @@ func resourceEBSSnapshotBlockPublicAccessPut(ctx context.Context, d *schema.Reso
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	state := d.Get(names.AttrState).(string)
-	input := &ec2.EnableSnapshotBlockPublicAccessInput{
-		State: types.SnapshotBlockPublicAccessState(state),
-	}
 
-	_, err := conn.EnableSnapshotBlockPublicAccess(ctx, input)
+	// EnableSnapshotBlockPublicAccess doesn't accept "unblocked".
+	if types.SnapshotBlockPublicAccessState(state) == types.SnapshotBlockPublicAccessStateUnblocked {
+		_, err := conn.DisableSnapshotBlockPublicAccess(ctx, &ec2.DisableSnapshotBlockPublicAccessInput{})
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "enabling EBS Snapshot Block Public Access (%s): %s", state, err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
+		}
+	} else {
+		input := &ec2.EnableSnapshotBlockPublicAccessInput{
+			State: types.SnapshotBlockPublicAccessState(state),
+		}
+
+		_, err := conn.EnableSnapshotBlockPublicAccess(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling EBS Snapshot Block Public Access (%s): %s", state, err)
+		}
 	}
 
 	if d.IsNewResource() {
@@ -47,6 +47,13 @@ func TestAccEC2EBSSnapshotBlockPublicAccess_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, "block-new-sharing"),
 				),
 			},
+			{
+				ResourceName: resourceName,
+				Config:       testAccEBSSnapshotBlockPublicAccess_basic(string(types.SnapshotBlockPublicAccessStateUnblocked)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "unblocked"),
+				),
+			},
 		},
 	})
 }
@@ -60,7 +67,7 @@ func testAccCheckEBSSnapshotBlockAccessDestroy(ctx context.Context) resource.Tes
 		}
 
 		if response.State != types.SnapshotBlockPublicAccessStateUnblocked {
-			return fmt.Errorf("EBS encryption by default is not in expected state (%s)", types.SnapshotBlockPublicAccessStateUnblocked)
+			return fmt.Errorf("EBS Snapshot Block Public Access is not in expected state (%s)", types.SnapshotBlockPublicAccessStateUnblocked)
 		}
 		return nil
 	}
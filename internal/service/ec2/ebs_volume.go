@@ -122,6 +122,13 @@ func resourceEBSVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"volume_initialization_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(100, 300),
+				RequiredWith: []string{names.AttrSnapshotID},
+			},
 		},
 	}
 }
@@ -172,6 +179,10 @@ func resourceEBSVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.VolumeType = awstypes.VolumeType(value.(string))
 	}
 
+	if value, ok := d.GetOk("volume_initialization_rate"); ok {
+		input.VolumeInitializationRate = aws.Int32(int32(value.(int)))
+	}
+
 	output, err := conn.CreateVolume(ctx, input)
 
 	if err != nil {
@@ -221,6 +232,7 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrSnapshotID, volume.SnapshotId)
 	d.Set(names.AttrThroughput, volume.Throughput)
 	d.Set(names.AttrType, volume.VolumeType)
+	d.Set("volume_initialization_rate", volume.VolumeInitializationRate)
 
 	setTagsOut(ctx, volume.Tags)
 
@@ -265,11 +277,16 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		_, err := conn.ModifyVolume(ctx, input)
 
+		// EBS allows only one modification per volume every 6 hours.
+		if tfawserr.ErrCodeEquals(err, errCodeVolumeModificationRateExceeded) {
+			return sdkdiag.AppendErrorf(diags, "modifying EBS Volume (%s): the volume was modified within the last 6 hours, wait before applying further size, type, IOPS or throughput changes: %s", d.Id(), err)
+		}
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "modifying EBS Volume (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitVolumeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitVolumeModificationComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EBS Volume (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -84,6 +84,25 @@ func resourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -393,6 +412,14 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap[names.AttrEnabled].(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), tfMap["with_cooldown"].(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -440,6 +467,9 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	if err := d.Set("deregistration_protection", flattenImageDeregistrationProtection(aws.ToString(image.DeregistrationProtection))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deregistration_protection: %s", err)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -503,6 +533,24 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("deregistration_protection") {
+		var enabled, withCooldown bool
+		if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			enabled, withCooldown = tfMap[names.AttrEnabled].(bool), tfMap["with_cooldown"].(bool)
+		}
+
+		if enabled {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), withCooldown); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -510,6 +558,20 @@ func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
+	// Deregistration protection must be disabled before the image can be deregistered.
+	// If the protection was enabled with a cooldown, deregistration fails until the cooldown period has elapsed.
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap[names.AttrEnabled].(bool) {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound, errCodeInvalidAMIIDUnavailable) {
+					return diags
+				}
+
+				return sdkdiag.AppendErrorf(diags, "deleting EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	log.Printf("[INFO] Deleting EC2 AMI: %s", d.Id())
 	_, err := conn.DeregisterImage(ctx, &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -620,6 +682,47 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.Client, id string) e
 	return nil
 }
 
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string, withCooldown bool) error {
+	input := &ec2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, true, withCooldown)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string) error {
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, false, false)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) awstypes.BlockDeviceMapping {
 	apiObject := awstypes.BlockDeviceMapping{
 		Ebs: &awstypes.EbsBlockDevice{},
@@ -813,6 +916,29 @@ func flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(apiObjects []awstypes.
 
 const imageDeprecationPropagationTimeout = 2 * time.Minute
 
+func waitImageDeregistrationProtectionUpdated(ctx context.Context, conn *ec2.Client, imageID string, expectedEnabled, expectedWithCooldown bool) error {
+	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
+		output, err := findImageByID(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		enabled, withCooldown := imageDeregistrationProtectionStatus(aws.ToString(output.DeregistrationProtection))
+
+		return enabled == expectedEnabled && withCooldown == expectedWithCooldown, nil
+	},
+		tfresource.WaitOpts{
+			Delay:      amiRetryDelay,
+			MinTimeout: amiRetryMinTimeout,
+		},
+	)
+}
+
 func waitImageDescriptionUpdated(ctx context.Context, conn *ec2.Client, imageID, expectedValue string) error {
 	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
 		output, err := findImageByID(ctx, conn, imageID)
@@ -891,3 +1017,19 @@ func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.Client, ima
 		},
 	)
 }
+
+// imageDeregistrationProtectionStatus parses the image's DeregistrationProtection value,
+// e.g. "disabled", "enabled-without-cooldown" or "enabled-with-cooldown".
+func imageDeregistrationProtectionStatus(v string) (bool, bool) {
+	return strings.HasPrefix(v, "enabled"), strings.HasPrefix(v, "enabled-with-cooldown")
+}
+
+func flattenImageDeregistrationProtection(v string) []interface{} {
+	enabled, withCooldown := imageDeregistrationProtectionStatus(v)
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: enabled,
+		"with_cooldown":   withCooldown,
+	}
+
+	return []interface{}{tfMap}
+}
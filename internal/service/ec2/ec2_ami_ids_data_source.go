@@ -50,6 +50,11 @@ func dataSourceAMIIDs() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			names.AttrMostRecent: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -119,15 +124,25 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta inte
 		filteredImages = images[:]
 	}
 
+	sortAscending := d.Get("sort_ascending").(bool)
 	slices.SortFunc(filteredImages, func(a, b awstypes.Image) int {
 		atime, _ := time.Parse(time.RFC3339, aws.ToString(a.CreationDate))
 		btime, _ := time.Parse(time.RFC3339, aws.ToString(b.CreationDate))
-		compare := atime.Compare(btime)
-		if d.Get("sort_ascending").(bool) {
-			return -compare
+		if sortAscending {
+			return atime.Compare(btime)
 		}
-		return compare
+		return btime.Compare(atime)
 	})
+
+	if d.Get(names.AttrMostRecent).(bool) && len(filteredImages) > 1 {
+		newest := slices.MaxFunc(filteredImages, func(a, b awstypes.Image) int {
+			atime, _ := time.Parse(time.RFC3339, aws.ToString(a.CreationDate))
+			btime, _ := time.Parse(time.RFC3339, aws.ToString(b.CreationDate))
+			return atime.Compare(btime)
+		})
+		filteredImages = []awstypes.Image{newest}
+	}
+
 	for _, image := range filteredImages {
 		imageIDs = append(imageIDs, aws.ToString(image.ImageId))
 	}
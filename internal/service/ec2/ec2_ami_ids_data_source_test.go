@@ -64,6 +64,29 @@ func TestAccEC2AMIIDsDataSource_sorted(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIIDsDataSource_mostRecent(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ami_ids.most_recent"
+
+	date := time.Now().UTC().AddDate(0, -2, 0)
+	creationDate := fmt.Sprintf("%d-%02d-*", date.Year(), date.Month())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIIDsDataSourceConfig_mostRecent(true, creationDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "data.aws_ami.test1", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMIIDsDataSource_includeDeprecated(t *testing.T) {
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_ami_ids.test"
@@ -134,6 +157,20 @@ data "aws_ami_ids" "test" {
 `, sortAscending, creationDate)
 }
 
+func testAccAMIIDsDataSourceConfig_mostRecent(sortAscending bool, creationDate string) string {
+	return acctest.ConfigCompose(testAccAMIIDsDataSourceConfig_sorted(sortAscending, creationDate), `
+data "aws_ami_ids" "most_recent" {
+  owners      = ["amazon"]
+  most_recent = true
+
+  filter {
+    name   = "name"
+    values = [data.aws_ami.test1.name, data.aws_ami.test2.name]
+  }
+}
+`)
+}
+
 func testAccAMIIDsDataSourceConfig_includeDeprecated(includeDeprecated bool) string {
 	return fmt.Sprintf(`
 data "aws_ami_ids" "test" {
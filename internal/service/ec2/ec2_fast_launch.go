@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_fast_launch", name="Fast Launch")
+func newFastLaunchResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fastLaunchResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type fastLaunchResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*fastLaunchResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_fast_launch"
+}
+
+func (r *fastLaunchResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"image_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_parallel_launches": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(6),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FastLaunchResourceType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FastLaunchStateCode](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrLaunchTemplate: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[fastLaunchLaunchTemplateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"snapshot_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[fastLaunchSnapshotConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"target_resource_count": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *fastLaunchResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fastLaunchResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	imageID := data.ImageID.ValueString()
+	input := &ec2.EnableFastLaunchInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.EnableFastLaunch(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("enabling EC2 Fast Launch (%s)", imageID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(imageID)
+
+	output, err := waitFastLaunchImageEnabled(ctx, conn, imageID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Fast Launch (%s) enable", imageID), err.Error())
+
+		return
+	}
+
+	data.MaxParallelLaunches = fwflex.Int32ToFramework(ctx, output.MaxParallelLaunches)
+	data.ResourceType = fwtypes.StringEnumValue(output.ResourceType)
+	data.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fastLaunchResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fastLaunchResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findFastLaunchImageByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Fast Launch (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	launchTemplate, snapshotConfiguration := data.LaunchTemplate, data.SnapshotConfiguration
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// EC2 returns its own defaults for the launch template and snapshot configuration
+	// when they aren't specified, so only track them when they are configured.
+	if launchTemplate.IsNull() {
+		data.LaunchTemplate = launchTemplate
+	}
+	if snapshotConfiguration.IsNull() {
+		data.SnapshotConfiguration = snapshotConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fastLaunchResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new fastLaunchResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Calling EnableFastLaunch on an image that already has Fast Launch enabled updates its settings.
+	imageID := new.ImageID.ValueString()
+	input := &ec2.EnableFastLaunchInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.EnableFastLaunch(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Fast Launch (%s)", imageID), err.Error())
+
+		return
+	}
+
+	output, err := waitFastLaunchImageEnabled(ctx, conn, imageID, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Fast Launch (%s) update", imageID), err.Error())
+
+		return
+	}
+
+	new.MaxParallelLaunches = fwflex.Int32ToFramework(ctx, output.MaxParallelLaunches)
+	new.ResourceType = fwtypes.StringEnumValue(output.ResourceType)
+	new.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *fastLaunchResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fastLaunchResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DisableFastLaunch(ctx, &ec2.DisableFastLaunchInput{
+		ImageId: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound, errCodeInvalidAMIIDUnavailable) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("disabling EC2 Fast Launch (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFastLaunchImageDisabled(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Fast Launch (%s) disable", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type fastLaunchResourceModel struct {
+	ID                    types.String                                                          `tfsdk:"id"`
+	ImageID               types.String                                                          `tfsdk:"image_id"`
+	LaunchTemplate        fwtypes.ListNestedObjectValueOf[fastLaunchLaunchTemplateModel]        `tfsdk:"launch_template"`
+	MaxParallelLaunches   types.Int64                                                           `tfsdk:"max_parallel_launches"`
+	ResourceType          fwtypes.StringEnum[awstypes.FastLaunchResourceType]                   `tfsdk:"resource_type"`
+	SnapshotConfiguration fwtypes.ListNestedObjectValueOf[fastLaunchSnapshotConfigurationModel] `tfsdk:"snapshot_configuration"`
+	State                 fwtypes.StringEnum[awstypes.FastLaunchStateCode]                      `tfsdk:"state"`
+	Timeouts              timeouts.Value                                                        `tfsdk:"timeouts"`
+}
+
+type fastLaunchLaunchTemplateModel struct {
+	LaunchTemplateID types.String `tfsdk:"id"`
+	Version          types.String `tfsdk:"version"`
+}
+
+type fastLaunchSnapshotConfigurationModel struct {
+	TargetResourceCount types.Int64 `tfsdk:"target_resource_count"`
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2FastLaunch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+	imageResourceName := "aws_ami_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", imageResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, imageResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "max_parallel_launches"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2FastLaunch_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceFastLaunch, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2FastLaunch_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_full(rName, 6, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.id", launchTemplateResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "6"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrLaunchTemplate, "snapshot_configuration"},
+			},
+			{
+				Config: testAccFastLaunchConfig_full(rName, 7, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "7"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastLaunchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_fast_launch" {
+				continue
+			}
+
+			_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Fast Launch %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFastLaunchExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// Fast Launch can only be enabled for Windows AMIs owned by the account, so copy the latest Amazon-owned one.
+func testAccFastLaunchConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_ami" "windows" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["Windows_Server-2022-English-Full-Base-*"]
+  }
+}
+
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.windows.id
+  source_ami_region = data.aws_region.current.name
+}
+`, rName)
+}
+
+func testAccFastLaunchConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFastLaunchConfig_base(rName), `
+resource "aws_ec2_fast_launch" "test" {
+  image_id = aws_ami_copy.test.id
+}
+`)
+}
+
+func testAccFastLaunchConfig_full(rName string, maxParallelLaunches, targetResourceCount int) string {
+	return acctest.ConfigCompose(
+		testAccFastLaunchConfig_base(rName),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.medium", "t2.medium"),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+}
+
+resource "aws_ec2_fast_launch" "test" {
+  image_id              = aws_ami_copy.test.id
+  max_parallel_launches = %[2]d
+  resource_type         = "snapshot"
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  snapshot_configuration {
+    target_resource_count = %[3]d
+  }
+}
+`, rName, maxParallelLaunches, targetResourceCount))
+}
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -50,6 +51,11 @@ func dataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"launched_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
 			"private_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -100,9 +106,19 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
 	}
 
+	var launchedAfter time.Time
+	if v, ok := d.GetOk("launched_after"); ok {
+		launchedAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
 	var instanceIDs, privateIPs, publicIPs, ipv6Addresses []string
 
 	for _, v := range output {
+		// DescribeInstances has no server-side launch time comparison filter.
+		if !launchedAfter.IsZero() && !aws.ToTime(v.LaunchTime).After(launchedAfter) {
+			continue
+		}
+
 		instanceIDs = append(instanceIDs, aws.ToString(v.InstanceId))
 		if privateIP := aws.ToString(v.PrivateIpAddress); privateIP != "" {
 			privateIPs = append(privateIPs, privateIP)
@@ -74,6 +74,28 @@ func TestAccEC2InstancesDataSource_instanceStateNames(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstancesDataSource_launchedAfter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_launchedAfter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_instances.past", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_instances.past", "private_ips.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_instances.future", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_instances.future", "private_ips.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2InstancesDataSource_empty(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -145,6 +167,43 @@ data "aws_instances" "test" {
 `, rName))
 }
 
+func testAccInstancesDataSourceConfig_launchedAfter(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  count         = 2
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_instances" "past" {
+  launched_after = "2000-01-01T00:00:00Z"
+
+  filter {
+    name   = "instance-id"
+    values = aws_instance.test[*].id
+  }
+}
+
+data "aws_instances" "future" {
+  launched_after = "2100-01-01T00:00:00Z"
+
+  filter {
+    name   = "instance-id"
+    values = aws_instance.test[*].id
+  }
+}
+`, rName))
+}
+
 func testAccInstancesDataSourceConfig_tags(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
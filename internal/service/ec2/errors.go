@@ -139,6 +139,7 @@ const (
 	errCodeVPNConnectionLimitExceeded                              = "VpnConnectionLimitExceeded"
 	errCodeVPNGatewayLimitExceeded                                 = "VpnGatewayLimitExceeded"
 	errCodeVolumeInUse                                             = "VolumeInUse"
+	errCodeVolumeModificationRateExceeded                          = "VolumeModificationRateExceeded"
 )
 
 func cancelSpotFleetRequestError(apiObject *awstypes.CancelSpotFleetRequestsError) error {
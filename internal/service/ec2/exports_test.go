@@ -28,6 +28,7 @@ var (
 	ResourceEIP                                           = resourceEIP
 	ResourceEIPAssociation                                = resourceEIPAssociation
 	ResourceEIPDomainName                                 = newEIPDomainNameResource
+	ResourceFastLaunch                                    = newFastLaunchResource
 	ResourceFleet                                         = resourceFleet
 	ResourceFlowLog                                       = resourceFlowLog
 	ResourceHost                                          = resourceHost
@@ -70,6 +71,7 @@ var (
 	ResourceSecurityGroupEgressRule                       = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                      = newSecurityGroupIngressRuleResource
 	ResourceSecurityGroupRule                             = resourceSecurityGroupRule
+	ResourceSecurityGroupRulesExclusive                   = newResourceSecurityGroupRulesExclusive
 	ResourceSecurityGroupVPCAssociation                   = newResourceSecurityGroupVPCAssociation
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
@@ -148,6 +150,7 @@ var (
 	FindEIPByAssociationID                                     = findEIPByAssociationID
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
 	FindEgressOnlyInternetGatewayByID                          = findEgressOnlyInternetGatewayByID
+	FindFastLaunchImageByID                                    = findFastLaunchImageByID
 	FindFastSnapshotRestoreByTwoPartKey                        = findFastSnapshotRestoreByTwoPartKey
 	FindFleetByID                                              = findFleetByID
 	FindFlowLogByID                                            = findFlowLogByID
@@ -192,6 +195,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRuleIDsExclusive                          = findSecurityGroupRuleIDsExclusive
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
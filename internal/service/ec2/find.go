@@ -6257,6 +6257,61 @@ func findFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.Client,
 	return output, nil
 }
 
+func findFastLaunchImage(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFastLaunchImagesInput) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	output, err := findFastLaunchImages(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFastLaunchImages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFastLaunchImagesInput) ([]awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	var output []awstypes.DescribeFastLaunchImagesSuccessItem
+
+	pages := ec2.NewDescribeFastLaunchImagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound, errCodeInvalidAMIIDUnavailable) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FastLaunchImages...)
+	}
+
+	return output, nil
+}
+
+func findFastLaunchImageByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	input := &ec2.DescribeFastLaunchImagesInput{
+		ImageIds: []string{id},
+	}
+
+	output, err := findFastLaunchImage(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ImageId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findTrafficMirrorFilter(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTrafficMirrorFiltersInput) (*awstypes.TrafficMirrorFilter, error) {
 	output, err := findTrafficMirrorFilters(ctx, conn, input)
 
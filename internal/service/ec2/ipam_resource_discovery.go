@@ -79,6 +79,10 @@ func resourceIPAMResourceDiscovery() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -156,6 +160,7 @@ func resourceIPAMResourceDiscoveryRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "setting operating_regions: %s", err)
 	}
 	d.Set(names.AttrOwnerID, rd.OwnerId)
+	d.Set(names.AttrState, rd.State)
 
 	setTagsOut(ctx, rd.Tags)
 
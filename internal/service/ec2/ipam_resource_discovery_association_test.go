@@ -40,6 +40,7 @@ func testAccIPAMResourceDiscoveryAssociation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", ipamName, names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, "ipam_resource_discovery_id", rdName, names.AttrID),
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.IpamResourceDiscoveryAssociationStateAssociateComplete)),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
 			},
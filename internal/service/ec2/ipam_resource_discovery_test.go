@@ -61,6 +61,7 @@ func testAccIPAMResourceDiscovery_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "is_default", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.IpamResourceDiscoveryStateCreateComplete)),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
 			},
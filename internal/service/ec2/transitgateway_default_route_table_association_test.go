@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -53,6 +54,50 @@ func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T, semap
 	})
 }
 
+func testAccTransitGatewayDefaultRouteTableAssociation_update(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var transitgateway awstypes.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	resourceRouteTable1Name := "aws_ec2_transit_gateway_route_table.test1"
+	resourceRouteTable2Name := "aws_ec2_transit_gateway_route_table.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitgatewayDefaultRouteTableAssociationConfig_update("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &transitgateway),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", resourceRouteTable1Name, names.AttrID),
+				),
+			},
+			{
+				Config: testAccTransitgatewayDefaultRouteTableAssociationConfig_update("test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &transitgateway),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", resourceRouteTable2Name, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -200,3 +245,22 @@ resource "aws_ec2_transit_gateway_default_route_table_association" "test" {
 }
 `
 }
+
+func testAccTransitgatewayDefaultRouteTableAssociationConfig_update(routeTableResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_route_table" "test1" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test2" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+
+resource "aws_ec2_transit_gateway_default_route_table_association" "test" {
+  transit_gateway_id             = aws_ec2_transit_gateway.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.%[1]s.id
+}
+`, routeTableResourceName)
+}
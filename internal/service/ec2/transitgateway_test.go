@@ -55,6 +55,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			acctest.CtBasic:            testAccTransitGatewayDefaultRouteTableAssociation_basic,
 			acctest.CtDisappears:       testAccTransitGatewayDefaultRouteTableAssociation_disappears,
 			"disappearsTransitGateway": testAccTransitGatewayDefaultRouteTableAssociation_Disappears_transitGateway,
+			"update":                   testAccTransitGatewayDefaultRouteTableAssociation_update,
 		},
 		"DefaultRouteTablePropagation": {
 			acctest.CtBasic:            testAccTransitGatewayDefaultRouteTablePropagation_basic,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -218,7 +219,10 @@ func resourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceVPCEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -518,6 +522,48 @@ func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceVPCEndpointCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var ipAddressType, dnsRecordIPType string
+	var privateDNSOnlyForInboundResolverEndpoint bool
+
+	if v := diff.GetRawConfig().GetAttr(names.AttrIPAddressType); v.IsKnown() && !v.IsNull() {
+		ipAddressType = v.AsString()
+	}
+
+	if v := diff.GetRawConfig().GetAttr("dns_options"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		tfMap := v.AsValueSlice()[0]
+
+		if v := tfMap.GetAttr("dns_record_ip_type"); v.IsKnown() && !v.IsNull() {
+			dnsRecordIPType = v.AsString()
+		}
+		if v := tfMap.GetAttr("private_dns_only_for_inbound_resolver_endpoint"); v.IsKnown() && !v.IsNull() {
+			privateDNSOnlyForInboundResolverEndpoint = v.True()
+		}
+	}
+
+	if ipAddressType != "" && dnsRecordIPType != "" {
+		switch awstypes.IpAddressType(ipAddressType) {
+		case awstypes.IpAddressTypeIpv4:
+			if dnsRecordIPType != string(awstypes.DnsRecordIpTypeIpv4) && dnsRecordIPType != string(awstypes.DnsRecordIpTypeServiceDefined) {
+				return fmt.Errorf(`dns_options.0.dns_record_ip_type = "%s" is not supported with ip_address_type = "%s"`, dnsRecordIPType, ipAddressType)
+			}
+		case awstypes.IpAddressTypeIpv6:
+			if dnsRecordIPType != string(awstypes.DnsRecordIpTypeIpv6) && dnsRecordIPType != string(awstypes.DnsRecordIpTypeServiceDefined) {
+				return fmt.Errorf(`dns_options.0.dns_record_ip_type = "%s" is not supported with ip_address_type = "%s"`, dnsRecordIPType, ipAddressType)
+			}
+		}
+	}
+
+	if privateDNSOnlyForInboundResolverEndpoint {
+		// An omitted private_dns_enabled is sent as false on create.
+		if v := diff.GetRawConfig().GetAttr("private_dns_enabled"); v.IsKnown() && (v.IsNull() || v.False()) {
+			return fmt.Errorf("dns_options.0.private_dns_only_for_inbound_resolver_endpoint requires private_dns_enabled = true")
+		}
+	}
+
+	return nil
+}
+
 func vpcEndpointAccept(ctx context.Context, conn *ec2.Client, vpceID, serviceName string, timeout time.Duration) error {
 	serviceConfiguration, err := findVPCEndpointServiceConfigurationByServiceName(ctx, conn, serviceName)
 
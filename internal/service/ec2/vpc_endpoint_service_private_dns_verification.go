@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -43,12 +44,21 @@ func (*vpcEndpointServicePrivateDNSVerificationResource) Metadata(_ context.Cont
 func (r *vpcEndpointServicePrivateDNSVerificationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
 			"service_id": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed: true,
+			},
 			"wait_for_verification": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -82,19 +92,43 @@ func (r *vpcEndpointServicePrivateDNSVerificationResource) Create(ctx context.Co
 		return
 	}
 
+	var privateDNSNameConfiguration *awstypes.PrivateDnsNameConfiguration
 	if data.WaitForVerification.ValueBool() {
-		if _, err := waitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, data.ServiceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		privateDNSNameConfiguration, err = waitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, data.ServiceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Endpoint Service Private DNS Verification (%s)", data.ServiceID.ValueString()), err.Error())
 
 			return
 		}
+	} else {
+		serviceConfiguration, err := findVPCEndpointServiceConfigurationByID(ctx, conn, data.ServiceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading VPC Endpoint Service (%s)", data.ServiceID.ValueString()), err.Error())
+
+			return
+		}
+
+		privateDNSNameConfiguration = serviceConfiguration.PrivateDnsNameConfiguration
 	}
 
+	if privateDNSNameConfiguration == nil {
+		privateDNSNameConfiguration = &awstypes.PrivateDnsNameConfiguration{}
+	}
+
+	data.Name = fwflex.StringToFramework(ctx, privateDNSNameConfiguration.Name)
+	data.Type = fwflex.StringToFramework(ctx, privateDNSNameConfiguration.Type)
+	data.Value = fwflex.StringToFramework(ctx, privateDNSNameConfiguration.Value)
+
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
 type vpcEndpointServicePrivateDNSVerificationResourceModel struct {
+	Name                types.String   `tfsdk:"name"`
 	ServiceID           types.String   `tfsdk:"service_id"`
 	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	Type                types.String   `tfsdk:"type"`
+	Value               types.String   `tfsdk:"value"`
 	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
 }
@@ -38,6 +38,9 @@ func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
 				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, domainName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "service_id", endpointServiceResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, endpointServiceResourceName, "private_dns_name_configuration.0.name"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "TXT"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrValue, endpointServiceResourceName, "private_dns_name_configuration.0.value"),
 				),
 			},
 		},
@@ -866,7 +866,7 @@ func flattenRoutes(ctx context.Context, conn *ec2.Client, d *schema.ResourceData
 			continue
 		}
 
-		if apiObject.Origin == awstypes.RouteOriginEnableVgwRoutePropagation {
+		if isPropagatedRoute(apiObject) {
 			continue
 		}
 
@@ -893,6 +893,21 @@ func flattenRoutes(ctx context.Context, conn *ec2.Client, d *schema.ResourceData
 	return tfList
 }
 
+// isPropagatedRoute returns whether the route was propagated into the route table
+// rather than created by a CreateRoute or CreateRouteTable call.
+// Propagated routes are not managed by Terraform and must not be tracked in `route`.
+// Routes propagated by a transit gateway into a VPC route table report the
+// EnableVgwRoutePropagation origin; transit gateway route table propagations
+// are not present in VPC route tables at all.
+func isPropagatedRoute(apiObject awstypes.Route) bool {
+	switch apiObject.Origin {
+	case awstypes.RouteOriginEnableVgwRoutePropagation:
+		return true
+	default:
+		return false
+	}
+}
+
 // hasLocalConfig along with flattenRoutes prevents default local routes from
 // being stored in state but allows configured local routes to be stored in
 // state. hasLocalConfig checks the ResourceData and flattenRoutes skips or
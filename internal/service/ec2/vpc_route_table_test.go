@@ -595,6 +595,47 @@ func TestAccVPCRouteTable_vgwRoutePropagation(t *testing.T) {
 	})
 }
 
+func TestAccVPCRouteTable_vgwRoutePropagationWithStaticRoutes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	vgwResourceName := "aws_vpn_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	propagatedCIDR := "172.16.10.0/24"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableConfig_vgwPropagationStaticRoutes(rName, rBgpAsn, propagatedCIDR),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, resourceName, &routeTable),
+					testAccCheckRouteTableWaitForVGWPropagatedRoute(ctx, &routeTable, propagatedCIDR),
+					resource.TestCheckResourceAttr(resourceName, "propagating_vgws.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "propagating_vgws.*", vgwResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, "0.0.0.0/0", "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				// The VGW-propagated route must not show up as drift against the configured routes.
+				Config:   testAccVPCRouteTableConfig_vgwPropagationStaticRoutes(rName, rBgpAsn, propagatedCIDR),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVPCRouteTable_conditionalCIDRBlock(t *testing.T) {
 	ctx := acctest.Context(t)
 	var routeTable awstypes.RouteTable
@@ -1322,6 +1363,29 @@ func testAccCheckRouteTableWaitForVPCEndpointRoute(ctx context.Context, routeTab
 	}
 }
 
+// testAccCheckRouteTableWaitForVGWPropagatedRoute returns a TestCheckFunc which waits for
+// a VGW-propagated route to the specified destination to appear in the specified route table.
+func testAccCheckRouteTableWaitForVGWPropagatedRoute(ctx context.Context, routeTable *awstypes.RouteTable, destinationCIDRBlock string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		return retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+			output, err := tfec2.FindRouteTableByID(ctx, conn, aws.ToString(routeTable.RouteTableId))
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			for _, route := range output.Routes {
+				if route.Origin == awstypes.RouteOriginEnableVgwRoutePropagation && aws.ToString(route.DestinationCidrBlock) == destinationCIDRBlock {
+					return nil
+				}
+			}
+
+			return retry.RetryableError(fmt.Errorf("VGW-propagated route (%s) not found", destinationCIDRBlock))
+		})
+	}
+}
+
 func testAccVPCRouteTableConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -1576,6 +1640,80 @@ resource "aws_route_table" "test" {
 `, rName, vgwResourceName)
 }
 
+func testAccVPCRouteTableConfig_vgwPropagationStaticRoutes(rName string, rBgpAsn int, destinationCIDRBlock string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_gateway_attachment" "test" {
+  vpc_id         = aws_vpc.test.id
+  vpn_gateway_id = aws_vpn_gateway.test.id
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "182.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id      = aws_vpn_gateway_attachment.test.vpn_gateway_id
+  customer_gateway_id = aws_customer_gateway.test.id
+  type                = "ipsec.1"
+  static_routes_only  = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection_route" "test" {
+  destination_cidr_block = %[3]q
+  vpn_connection_id      = aws_vpn_connection.test.id
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  propagating_vgws = [aws_vpn_gateway_attachment.test.vpn_gateway_id]
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpn_connection_route.test]
+}
+`, rName, rBgpAsn, destinationCIDRBlock)
+}
+
 func testAccVPCRouteTableConfig_noDestination(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
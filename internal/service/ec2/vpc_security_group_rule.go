@@ -52,6 +52,8 @@ func resourceSecurityGroupRule() *schema.Resource {
 		SchemaVersion: 2,
 		MigrateState:  securityGroupRuleMigrateState,
 
+		CustomizeDiff: resourceSecurityGroupRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cidr_blocks": {
 				Type:     schema.TypeList,
@@ -525,6 +527,43 @@ func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceSecurityGroupRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown(names.AttrProtocol) || !diff.NewValueKnown("from_port") || !diff.NewValueKnown("to_port") {
+		return nil
+	}
+
+	protocol := protocolForValue(diff.Get(names.AttrProtocol).(string))
+	fromPort, toPort := diff.Get("from_port").(int), diff.Get("to_port").(int)
+
+	switch protocol {
+	case "-1":
+		// All ports are allowed regardless of from_port and to_port.
+		return nil
+	case "icmp", "icmpv6":
+		// from_port and to_port are the ICMP type and code, not a port range.
+		return nil
+	}
+
+	if fromPort == -1 || toPort == -1 {
+		return fmt.Errorf("from_port and to_port may only be -1 when protocol is \"icmp\", \"icmpv6\" or \"-1\", got protocol %q", protocol)
+	}
+
+	if protocol == "tcp" || protocol == "udp" {
+		if fromPort < 0 || fromPort > 65535 {
+			return fmt.Errorf("from_port (%d) must be between 0 and 65535 when protocol is %q", fromPort, protocol)
+		}
+		if toPort < 0 || toPort > 65535 {
+			return fmt.Errorf("to_port (%d) must be between 0 and 65535 when protocol is %q", toPort, protocol)
+		}
+	}
+
+	if fromPort > toPort {
+		return fmt.Errorf("from_port (%d) must be less than or equal to to_port (%d)", fromPort, toPort)
+	}
+
+	return nil
+}
+
 func findRuleMatch(p awstypes.IpPermission, rules []awstypes.IpPermission) (*awstypes.IpPermission, *string) {
 	var rule *awstypes.IpPermission
 	var description *string
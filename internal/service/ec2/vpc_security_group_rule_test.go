@@ -469,6 +469,32 @@ func TestAccVPCSecurityGroupRule_expectInvalidCIDR(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupRule_expectInvalidPorts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSecurityGroupRuleConfig_ports(rName, "tcp", 443, 80),
+				ExpectError: regexache.MustCompile(`from_port \(443\) must be less than or equal to to_port \(80\)`),
+			},
+			{
+				Config:      testAccVPCSecurityGroupRuleConfig_ports(rName, "udp", -1, -1),
+				ExpectError: regexache.MustCompile(`from_port and to_port may only be -1 when protocol is`),
+			},
+			{
+				Config:      testAccVPCSecurityGroupRuleConfig_ports(rName, "tcp", 0, 65536),
+				ExpectError: regexache.MustCompile(`to_port \(65536\) must be between 0 and 65535`),
+			},
+		},
+	})
+}
+
 // testing partial match implementation
 func TestAccVPCSecurityGroupRule_PartialMatching_basic(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -2430,6 +2456,27 @@ resource "aws_security_group_rule" "test" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupRuleConfig_ports(rName, protocol string, fromPort, toPort int) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group_rule" "test" {
+  type              = "ingress"
+  from_port         = %[3]d
+  to_port           = %[4]d
+  protocol          = %[2]q
+  cidr_blocks       = ["0.0.0.0/0"]
+  security_group_id = aws_security_group.test.id
+}
+`, rName, protocol, fromPort, toPort)
+}
+
 func testAccVPCSecurityGroupRuleConfig_invalidIPv6CIDR(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newResourceSecurityGroupRulesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRulesExclusive{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type resourceSecurityGroupRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceSecurityGroupRulesExclusiveModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingressRuleIDs, egressRuleIDs []string
+	resp.Diagnostics.Append(plan.IngressRuleIDs.ElementsAs(ctx, &ingressRuleIDs, false)...)
+	resp.Diagnostics.Append(plan.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ingressRuleIDs, egressRuleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceSecurityGroupRulesExclusiveModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.SecurityGroupID.ValueString()
+	_, err := findSecurityGroupByID(ctx, conn, groupID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var egressRuleIDs []string
+	resp.Diagnostics.Append(state.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingress, egress, err := findSecurityGroupRuleIDsExclusive(ctx, conn, groupID, egressRuleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.EgressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, egress)
+	state.IngressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ingress)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceSecurityGroupRulesExclusiveModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IngressRuleIDs.Equal(state.IngressRuleIDs) || !plan.EgressRuleIDs.Equal(state.EgressRuleIDs) {
+		var ingressRuleIDs, egressRuleIDs []string
+		resp.Diagnostics.Append(plan.IngressRuleIDs.ElementsAs(ctx, &ingressRuleIDs, false)...)
+		resp.Diagnostics.Append(plan.EgressRuleIDs.ElementsAs(ctx, &egressRuleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ingressRuleIDs, egressRuleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules present on the security group but not configured on this resource
+// are revoked. The default allow-all egress rule is left in place unless
+// it is explicitly listed in egress_rule_ids. Rules configured on this resource
+// must already exist, as this resource does not create rules.
+func (r *resourceSecurityGroupRulesExclusive) syncRules(ctx context.Context, groupID string, wantIngress, wantEgress []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsExclusive(ctx, conn, groupID, wantEgress)
+	if err != nil {
+		return err
+	}
+
+	missingIngress, removeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 })
+	missingEgress, removeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 })
+
+	if missing := slices.Concat(missingIngress, missingEgress); len(missing) > 0 {
+		return fmt.Errorf("security group rules %v not found in security group (%s)", missing, groupID)
+	}
+
+	if len(removeIngress) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeIngress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	if len(removeEgress) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeEgress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceSecurityGroupRulesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+// findSecurityGroupRuleIDsExclusive returns the IDs of the security group's ingress and egress rules.
+// The default allow-all egress rule is omitted unless its ID is in managedEgressRuleIDs.
+func findSecurityGroupRuleIDsExclusive(ctx context.Context, conn *ec2.Client, groupID string, managedEgressRuleIDs []string) ([]string, []string, error) {
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []string
+	for _, rule := range rules {
+		id := aws.ToString(rule.SecurityGroupRuleId)
+
+		if !aws.ToBool(rule.IsEgress) {
+			ingress = append(ingress, id)
+			continue
+		}
+
+		if isDefaultSecurityGroupEgressRule(rule) && !slices.Contains(managedEgressRuleIDs, id) {
+			continue
+		}
+
+		egress = append(egress, id)
+	}
+
+	return ingress, egress, nil
+}
+
+// isDefaultSecurityGroupEgressRule returns whether the rule is one of the allow-all
+// egress rules that EC2 adds to every new VPC security group.
+func isDefaultSecurityGroupEgressRule(rule awstypes.SecurityGroupRule) bool {
+	if !aws.ToBool(rule.IsEgress) || aws.ToString(rule.IpProtocol) != "-1" {
+		return false
+	}
+
+	if rule.PrefixListId != nil || rule.ReferencedGroupInfo != nil {
+		return false
+	}
+
+	return aws.ToString(rule.CidrIpv4) == "0.0.0.0/0" || aws.ToString(rule.CidrIpv6) == "::/0"
+}
+
+type resourceSecurityGroupRulesExclusiveModel struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
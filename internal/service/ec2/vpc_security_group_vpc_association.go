@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -223,6 +224,8 @@ func waitSecurityGroupVPCAssociationCreated(ctx context.Context, conn *ec2.Clien
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StateReason)))
+
 		return out, err
 	}
 
@@ -239,6 +242,8 @@ func waitSecurityGroupVPCAssociationDeleted(ctx context.Context, conn *ec2.Clien
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StateReason)))
+
 		return out, err
 	}
 
@@ -282,13 +287,18 @@ func FindSecurityGroupVPCAssociationByTwoPartKey(ctx context.Context, conn *ec2.
 		}
 
 		for _, association := range page.SecurityGroupVpcAssociations {
-			if association.GroupId == nil {
+			if aws.ToString(association.GroupId) != groupId || aws.ToString(association.VpcId) != vpcId {
 				continue
 			}
 
-			if association.GroupId != nil && association.VpcId != nil {
-				return &association, nil
+			if state := association.State; state == awstypes.SecurityGroupVpcAssociationStateDisassociated {
+				return nil, &retry.NotFoundError{
+					Message:     string(state),
+					LastRequest: in,
+				}
 			}
+
+			return &association, nil
 		}
 	}
 
@@ -141,6 +141,43 @@ func TestAccVPCSecurityGroupVPCAssociation_disappears_VPC(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupVPCAssociation_peeredVPC(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var assoc types.SecurityGroupVpcAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_vpc_association.test"
+	sgResourceName := "aws_security_group.test"
+	vpcResourceName := "aws_vpc.target"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCSecurityGroupVPCAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupVPCAssociationConfig_peeredVPC(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupVPCAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", sgResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.SecurityGroupVpcAssociationStateAssociated)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccVPCSecurityGroupVPCAssociationImportStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrVPCID,
+			},
+		},
+	})
+}
+
 func testAccCheckVPCSecurityGroupVPCAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -230,3 +267,43 @@ resource "aws_vpc_security_group_vpc_association" "test" {
 }
 `, rName)
 }
+
+func testAccVPCSecurityGroupVPCAssociationConfig_peeredVPC(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "source" {
+  cidr_block = "10.6.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "target" {
+  cidr_block = "10.7.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.source.id
+  peer_vpc_id = aws_vpc.target.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.source.id
+}
+
+resource "aws_vpc_security_group_vpc_association" "test" {
+  security_group_id = aws_security_group.test.id
+  vpc_id            = aws_vpc_peering_connection.test.peer_vpc_id
+}
+`, rName)
+}
@@ -505,6 +505,44 @@ func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, avail
 	return nil, err
 }
 
+func waitFastLaunchImageEnabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FastLaunchStateCodeEnabling),
+		Target:  enum.Slice(awstypes.FastLaunchStateCodeEnabled),
+		Refresh: statusFastLaunchImage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFastLaunchImageDisabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FastLaunchStateCodeDisabling, awstypes.FastLaunchStateCodeEnabled),
+		Target:  []string{},
+		Refresh: statusFastLaunchImage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target []string, timeout, delay time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    pending,
@@ -2658,25 +2696,6 @@ func waitVolumeModificationComplete(ctx context.Context, conn *ec2.Client, id st
 	return nil, err
 }
 
-func waitVolumeUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Volume, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.VolumeStateCreating, awstypes.VolumeState(awstypes.VolumeModificationStateModifying)),
-		Target:     enum.Slice(awstypes.VolumeStateAvailable, awstypes.VolumeStateInUse),
-		Refresh:    statusVolume(ctx, conn, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*awstypes.Volume); ok {
-		return output, err
-	}
-
-	return nil, err
-}
-
 func waitVPCAttributeUpdated(ctx context.Context, conn *ec2.Client, vpcID string, attribute awstypes.VpcAttributeName, expectedValue bool) (*awstypes.Vpc, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Target:     []string{strconv.FormatBool(expectedValue)},
@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -30,7 +31,7 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 		DeleteWithoutTimeout: resourceRegistryScanningConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRegistryScanningConfigurationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -148,6 +149,15 @@ func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceRegistryScanningConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The scanning configuration is a per-registry singleton, so the only valid import ID is the caller's account ID.
+	if accountID := meta.(*conns.AWSClient).AccountID(ctx); d.Id() != accountID {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected account ID (%s)", d.Id(), accountID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findRegistryScanningConfiguration(ctx context.Context, conn *ecr.Client) (*ecr.GetRegistryScanningConfigurationOutput, error) {
 	input := &ecr.GetRegistryScanningConfigurationInput{}
 
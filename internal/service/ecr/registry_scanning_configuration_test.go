@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -53,6 +54,12 @@ func testAccRegistryScanningConfiguration_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "000000000000",
+				ExpectError:   regexache.MustCompile(`expected account ID`),
+			},
 		},
 	})
 }
@@ -143,6 +143,9 @@ func (r *resourceReservedCacheNode) Create(ctx context.Context, request resource
 
 	var input elasticache.PurchaseReservedCacheNodesOfferingInput
 	response.Diagnostics.Append(flex.Expand(ctx, data, &input, r.flexOpts()...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	input.Tags = getTagsIn(ctx)
 
@@ -156,7 +159,8 @@ func (r *resourceReservedCacheNode) Create(ctx context.Context, request resource
 	}
 
 	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
-	if err := waitReservedCacheNodeCreated(ctx, conn, aws.ToString(resp.ReservedCacheNode.ReservedCacheNodeId), createTimeout); err != nil {
+	reservation, err := waitReservedCacheNodeCreated(ctx, conn, aws.ToString(resp.ReservedCacheNode.ReservedCacheNodeId), createTimeout)
+	if err != nil {
 		response.Diagnostics.AddError(
 			"Creating ElastiCache Reserved Cache Node",
 			fmt.Sprintf("Creating ElastiCache Reserved Cache Node with Offering ID %q failed while waiting for completion.\nError: %s", data.ReservedCacheNodesOfferingID.ValueString(), err.Error()),
@@ -164,12 +168,13 @@ func (r *resourceReservedCacheNode) Create(ctx context.Context, request resource
 		return
 	}
 
-	response.Diagnostics.Append(flex.Flatten(ctx, resp.ReservedCacheNode, &data, r.flexOpts()...)...)
+	// Flatten the reservation as it is once active so that state reflects the final values.
+	response.Diagnostics.Append(flex.Flatten(ctx, reservation, &data, r.flexOpts()...)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	duration := time.Duration(aws.ToInt32(resp.ReservedCacheNode.Duration)) * time.Second
+	duration := time.Duration(aws.ToInt32(reservation.Duration)) * time.Second
 	data.Duration = fwtypes.RFC3339DurationTimeDurationValue(duration)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -276,7 +281,7 @@ func findReservedCacheNodeByID(ctx context.Context, conn *elasticache.Client, id
 	return output.ReservedCacheNodes[0], nil
 }
 
-func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) error {
+func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.ReservedCacheNode, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			reservedCacheNodeStatePaymentPending,
@@ -289,9 +294,13 @@ func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client,
 		Delay:          30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(awstypes.ReservedCacheNode); ok {
+		return &output, err
+	}
 
-	return err
+	return nil, err
 }
 
 func statusReservedCacheNode(ctx context.Context, conn *elasticache.Client, id string) retry.StateRefreshFunc {
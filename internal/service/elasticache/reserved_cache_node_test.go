@@ -49,12 +49,12 @@ func TestAccElastiCacheReservedCacheNode_Redis_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDuration, resourceName, names.AttrDuration),
 					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
-					resource.TestCheckResourceAttrPair(dataSourceName, "reserved_cache_nodes_offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "reserved_cache_nodes_offering_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "product_description", resourceName, "product_description"),
 					resource.TestCheckResourceAttrSet(resourceName, "recurring_charges"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "active"),
 					resource.TestCheckResourceAttrSet(resourceName, "usage_price"),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -92,12 +92,12 @@ func TestAccElastiCacheReservedCacheNode_Valkey_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDuration, resourceName, names.AttrDuration),
 					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
-					resource.TestCheckResourceAttrPair(dataSourceName, "reserved_cache_nodes_offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "reserved_cache_nodes_offering_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "product_description", resourceName, "product_description"),
 					resource.TestCheckResourceAttrSet(resourceName, "recurring_charges"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "active"),
 					resource.TestCheckResourceAttrSet(resourceName, "usage_price"),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -165,7 +165,7 @@ func testAccReservedInstanceExists(ctx context.Context, n string, reservation *a
 func testAccReservedInstanceConfig_Redis_basic() string {
 	return `
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reserved_cache_nodes_offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
@@ -180,7 +180,7 @@ data "aws_elasticache_reserved_cache_node_offering" "test" {
 func testAccReservedInstanceConfig_Valkey_basic() string {
 	return `
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reserved_cache_nodes_offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
@@ -195,8 +195,8 @@ data "aws_elasticache_reserved_cache_node_offering" "test" {
 func testAccReservedInstanceConfig_ID(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
-  id          = %[1]q
+  reserved_cache_nodes_offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  id                               = %[1]q
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
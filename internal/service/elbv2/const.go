@@ -200,6 +200,10 @@ const (
 	healthCheckPortTrafficPort = "traffic-port"
 )
 
+const (
+	genevePort = 6081 // Gateway Load Balancer target groups must use this port
+)
+
 func healthCheckProtocolEnumValues() []string {
 	return enum.Slice[awstypes.ProtocolEnum](
 		awstypes.ProtocolEnumHttp,
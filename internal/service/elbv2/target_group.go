@@ -302,6 +302,7 @@ func resourceTargetGroup() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"on_deregistration": {
@@ -1104,6 +1105,40 @@ func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDi
 				protocol,
 			)
 		}
+	case awstypes.ProtocolEnumGeneve:
+		if diff.NewValueKnown(names.AttrPort) {
+			if v := diff.Get(names.AttrPort).(int); v != 0 && v != genevePort {
+				return fmt.Errorf("Attribute %q must have value %d when %q is %q.",
+					errs.PathString(cty.GetAttrPath(names.AttrPort)),
+					genevePort,
+					errs.PathString(cty.GetAttrPath(names.AttrProtocol)),
+					protocol,
+				)
+			}
+		}
+
+		if stickinesses := diff.Get("stickiness").([]interface{}); len(stickinesses) == 1 && stickinesses[0] != nil {
+			switch stickinessType := stickinesses[0].(map[string]interface{})[names.AttrType].(string); stickinessType {
+			case "", stickinessTypeSourceIPDestIP, stickinessTypeSourceIPDestIPProto:
+			default:
+				return fmt.Errorf("Attribute %q cannot have value %q when %q is %q.",
+					errs.PathString(cty.GetAttrPath("stickiness").IndexInt(0).GetAttr(names.AttrType)),
+					stickinessType,
+					errs.PathString(cty.GetAttrPath(names.AttrProtocol)),
+					protocol,
+				)
+			}
+		}
+	}
+
+	if diff.NewValueKnown(names.AttrProtocol) && protocol != awstypes.ProtocolEnumGeneve {
+		if v := diff.GetRawConfig().GetAttr("target_failover"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("Attribute %q can only be configured when %q is %q.",
+				errs.PathString(cty.GetAttrPath("target_failover")),
+				errs.PathString(cty.GetAttrPath(names.AttrProtocol)),
+				awstypes.ProtocolEnumGeneve,
+			)
+		}
 	}
 
 	if diff.Id() == "" {
@@ -1131,6 +1131,32 @@ func TestAccELBV2TargetGroup_Geneve_Sticky(t *testing.T) {
 	})
 }
 
+func TestAccELBV2TargetGroup_Geneve_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetGroupConfig_protocolGenevePort(rName, 6080),
+				ExpectError: regexache.MustCompile(`Attribute "port" must have value 6081 when "protocol" is "GENEVE"`),
+			},
+			{
+				Config:      testAccTargetGroupConfig_protocolGeneveSticky(rName, "lb_cookie"),
+				ExpectError: regexache.MustCompile(`Attribute "stickiness\[0\].type" cannot have value "lb_cookie" when "protocol" is "GENEVE"`),
+			},
+			{
+				Config:      testAccTargetGroupConfig_protocolHTTPTargetFailover(rName),
+				ExpectError: regexache.MustCompile(`Attribute "target_failover" can only be configured when "protocol" is "GENEVE"`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_Geneve_targetFailover(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.TargetGroup
@@ -4619,6 +4645,57 @@ resource "aws_lb_target_group" "test" {
 `, rName, failoverType)
 }
 
+func testAccTargetGroupConfig_protocolGenevePort(rName string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.10.0/25"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = %[2]d
+  protocol = "GENEVE"
+  vpc_id   = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, port)
+}
+
+func testAccTargetGroupConfig_protocolHTTPTargetFailover(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.10.0/25"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+
+  target_failover {
+    on_deregistration = "rebalance"
+    on_unhealthy      = "rebalance"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 func testAccTargetGroupConfig_grpcProtocolVersion(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
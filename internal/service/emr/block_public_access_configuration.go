@@ -5,6 +5,7 @@ package emr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -29,6 +30,8 @@ func resourceBlockPublicAccessConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBlockPublicAccessConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"block_public_security_group_rules": {
 				Type:     schema.TypeBool,
@@ -58,6 +61,21 @@ func resourceBlockPublicAccessConfiguration() *schema.Resource {
 	}
 }
 
+func resourceBlockPublicAccessConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get("permitted_public_security_group_rule_range").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if minRange, maxRange := tfMap["min_range"].(int), tfMap["max_range"].(int); minRange > maxRange {
+			return fmt.Errorf("permitted_public_security_group_rule_range.%d: min_range (%d) must be less than or equal to max_range (%d)", i, minRange, maxRange)
+		}
+	}
+
+	return nil
+}
+
 func resourceBlockPublicAccessConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRClient(ctx)
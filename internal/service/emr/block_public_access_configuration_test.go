@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -27,6 +28,8 @@ func TestAccEMRBlockPublicAccessConfiguration_serial(t *testing.T) {
 		acctest.CtDisappears: testAccBlockPublicAccessConfiguration_disappears,
 		"default":            testAccBlockPublicAccessConfiguration_default,
 		"enabledMultiRange":  testAccBlockPublicAccessConfiguration_enabledMultiRange,
+		"removeRange":        testAccBlockPublicAccessConfiguration_removeRange,
+		"invalidRange":       testAccBlockPublicAccessConfiguration_invalidRange,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -170,6 +173,61 @@ func testAccBlockPublicAccessConfiguration_enabledMultiRange(t *testing.T) {
 	})
 }
 
+func testAccBlockPublicAccessConfiguration_removeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_block_public_access_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EMREndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlockPublicAccessConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: blockPublicAccessConfigurationConfig_defaultString,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockPublicAccessConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "block_public_security_group_rules", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "permitted_public_security_group_rule_range.#", "1"),
+				),
+			},
+			{
+				Config: testAccBlockPublicAccessConfigurationConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockPublicAccessConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "block_public_security_group_rules", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "permitted_public_security_group_rule_range.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockPublicAccessConfiguration_invalidRange(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EMREndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlockPublicAccessConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      blockPublicAccessConfigurationConfig_invalidRangeString,
+				ExpectError: regexache.MustCompile(`min_range \(101\) must be less than or equal to max_range \(100\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockPublicAccessConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRClient(ctx)
@@ -268,3 +326,14 @@ resource "aws_emr_block_public_access_configuration" "test" {
   }
 }
 `
+
+const blockPublicAccessConfigurationConfig_invalidRangeString = `
+resource "aws_emr_block_public_access_configuration" "test" {
+  block_public_security_group_rules = true
+
+  permitted_public_security_group_rule_range {
+    min_range = 101
+    max_range = 100
+  }
+}
+`
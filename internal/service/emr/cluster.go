@@ -168,6 +168,34 @@ func resourceCluster() *schema.Resource {
 													ForceNew:         true,
 													ValidateDiagFunc: enum.Validate[awstypes.OnDemandProvisioningAllocationStrategy](),
 												},
+												"capacity_reservation_options": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"capacity_reservation_preference": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																ValidateDiagFunc: enum.Validate[awstypes.OnDemandCapacityReservationPreference](),
+															},
+															"capacity_reservation_resource_group_arn": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"usage_strategy": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																ValidateDiagFunc: enum.Validate[awstypes.OnDemandCapacityReservationUsageStrategy](),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -2248,6 +2276,25 @@ func flattenOnDemandProvisioningSpecification(apiObject *awstypes.OnDemandProvis
 		"allocation_strategy": strings.Replace(strings.ToLower(string(apiObject.AllocationStrategy)), "_", "-", -1),
 	}
 
+	if v := apiObject.CapacityReservationOptions; v != nil {
+		tfMap["capacity_reservation_options"] = flattenOnDemandCapacityReservationOptions(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOnDemandCapacityReservationOptions(apiObject *awstypes.OnDemandCapacityReservationOptions) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"capacity_reservation_resource_group_arn": aws.ToString(apiObject.CapacityReservationResourceGroupArn),
+		// Normalize the same way as allocation_strategy to avoid perpetual differences.
+		"capacity_reservation_preference": strings.Replace(strings.ToLower(string(apiObject.CapacityReservationPreference)), "_", "-", -1),
+		"usage_strategy":                  strings.Replace(strings.ToLower(string(apiObject.UsageStrategy)), "_", "-", -1),
+	}
+
 	return []interface{}{tfMap}
 }
 
@@ -2336,9 +2383,15 @@ func expandLaunchSpecification(tfMap map[string]interface{}) *awstypes.InstanceF
 	apiObject := &awstypes.InstanceFleetProvisioningSpecifications{}
 
 	if v := tfMap["on_demand_specification"].([]interface{}); len(v) > 0 {
-		apiObject.OnDemandSpecification = &awstypes.OnDemandProvisioningSpecification{
-			AllocationStrategy: awstypes.OnDemandProvisioningAllocationStrategy(v[0].(map[string]interface{})["allocation_strategy"].(string)),
+		tfMap := v[0].(map[string]interface{})
+		onDemandProvisioning := &awstypes.OnDemandProvisioningSpecification{
+			AllocationStrategy: awstypes.OnDemandProvisioningAllocationStrategy(tfMap["allocation_strategy"].(string)),
 		}
+		if v, ok := tfMap["capacity_reservation_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			onDemandProvisioning.CapacityReservationOptions = expandOnDemandCapacityReservationOptions(v[0].(map[string]interface{}))
+		}
+
+		apiObject.OnDemandSpecification = onDemandProvisioning
 	}
 
 	if v := tfMap["spot_specification"].([]interface{}); len(v) > 0 {
@@ -2360,6 +2413,24 @@ func expandLaunchSpecification(tfMap map[string]interface{}) *awstypes.InstanceF
 	return apiObject
 }
 
+func expandOnDemandCapacityReservationOptions(tfMap map[string]interface{}) *awstypes.OnDemandCapacityReservationOptions {
+	apiObject := &awstypes.OnDemandCapacityReservationOptions{}
+
+	if v, ok := tfMap["capacity_reservation_preference"].(string); ok && v != "" {
+		apiObject.CapacityReservationPreference = awstypes.OnDemandCapacityReservationPreference(v)
+	}
+
+	if v, ok := tfMap["capacity_reservation_resource_group_arn"].(string); ok && v != "" {
+		apiObject.CapacityReservationResourceGroupArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["usage_strategy"].(string); ok && v != "" {
+		apiObject.UsageStrategy = awstypes.OnDemandCapacityReservationUsageStrategy(v)
+	}
+
+	return apiObject
+}
+
 func expandConfigurations(tfList []interface{}) []awstypes.Configuration {
 	apiObjects := []awstypes.Configuration{}
 
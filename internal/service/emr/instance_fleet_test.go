@@ -153,6 +153,44 @@ func TestAccEMRInstanceFleet_full(t *testing.T) {
 	})
 }
 
+func TestAccEMRInstanceFleet_allocationStrategies(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet awstypes.InstanceFleet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emr_instance_fleet.task"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceFleetConfig_allocationStrategies(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.on_demand_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.on_demand_specification.0.allocation_strategy", "lowest-price"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.on_demand_specification.0.capacity_reservation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.on_demand_specification.0.capacity_reservation_options.0.capacity_reservation_preference", "none"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.spot_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specifications.0.spot_specification.0.allocation_strategy", "price-capacity-optimized"),
+					resource.TestCheckResourceAttr(resourceName, "target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_spot_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccInstanceFleetResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_type_configs"},
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceFleetExists(ctx context.Context, n string, v *awstypes.InstanceFleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -531,3 +569,43 @@ resource "aws_emr_instance_fleet" "task" {
 }
 `, rName))
 }
+
+func testAccInstanceFleetConfig_allocationStrategies(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_instance_fleet" "task" {
+  cluster_id = aws_emr_cluster.test.id
+
+  instance_type_configs {
+    bid_price_as_percentage_of_on_demand_price = 100
+    instance_type                              = "m4.xlarge"
+    weighted_capacity                          = 1
+  }
+
+  instance_type_configs {
+    bid_price_as_percentage_of_on_demand_price = 100
+    instance_type                              = "m5.xlarge"
+    weighted_capacity                          = 1
+  }
+
+  launch_specifications {
+    on_demand_specification {
+      allocation_strategy = "lowest-price"
+
+      capacity_reservation_options {
+        capacity_reservation_preference = "none"
+      }
+    }
+
+    spot_specification {
+      allocation_strategy      = "price-capacity-optimized"
+      timeout_action           = "SWITCH_TO_ON_DEMAND"
+      timeout_duration_minutes = 10
+    }
+  }
+
+  name                      = "emr_instance_fleet_%[1]s"
+  target_on_demand_capacity = 1
+  target_spot_capacity      = 1
+}
+`, rName))
+}
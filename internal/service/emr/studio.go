@@ -5,12 +5,14 @@ package emr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +40,10 @@ func resourceStudio() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceStudioCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -71,14 +76,18 @@ func resourceStudio() *schema.Resource {
 				ForceNew: true,
 			},
 			"idp_auth_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				RequiredWith: []string{"idp_relay_state_parameter_name"},
 			},
 			"idp_relay_state_parameter_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				RequiredWith: []string{"idp_auth_url"},
 			},
 			names.AttrName: {
 				Type:         schema.TypeString,
@@ -261,6 +270,23 @@ func resourceStudioUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceStudioRead(ctx, d, meta)...)
 }
 
+func resourceStudioCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("auth_mode") {
+		return nil
+	}
+
+	// A SAML identity provider can only be used with IAM authentication.
+	if authMode := awstypes.AuthMode(d.Get("auth_mode").(string)); authMode != awstypes.AuthModeIam {
+		for _, k := range []string{"idp_auth_url", "idp_relay_state_parameter_name"} {
+			if v, ok := d.GetOk(k); ok && v.(string) != "" {
+				return fmt.Errorf("%s must not be set when auth_mode is %s", k, authMode)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceStudioDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRClient(ctx)
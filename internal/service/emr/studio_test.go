@@ -115,6 +115,40 @@ func TestAccEMRStudio_iam(t *testing.T) {
 	})
 }
 
+func TestAccEMRStudio_iamIdP(t *testing.T) {
+	ctx := acctest.Context(t)
+	var studio awstypes.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStudioConfig_ssoIdP(rName),
+				ExpectError: regexache.MustCompile(`idp_auth_url must not be set when auth_mode is SSO`),
+			},
+			{
+				Config: testAccStudioConfig_iamIdP(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "auth_mode", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "idp_auth_url", "https://example.com/saml"),
+					resource.TestCheckResourceAttr(resourceName, "idp_relay_state_parameter_name", "RelayState"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEMRStudio_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var studio awstypes.Studio
@@ -370,6 +404,41 @@ resource "aws_emr_studio" "test" {
 `, rName))
 }
 
+func testAccStudioConfig_iamIdP(rName string) string {
+	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                      = "IAM"
+  default_s3_location            = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id       = aws_security_group.test.id
+  idp_auth_url                   = "https://example.com/saml"
+  idp_relay_state_parameter_name = "RelayState"
+  name                           = %[1]q
+  service_role                   = aws_iam_role.test.arn
+  subnet_ids                     = aws_subnet.test[*].id
+  vpc_id                         = aws_vpc.test.id
+  workspace_security_group_id    = aws_security_group.test.id
+}
+`, rName))
+}
+
+func testAccStudioConfig_ssoIdP(rName string) string {
+	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                      = "SSO"
+  default_s3_location            = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id       = aws_security_group.test.id
+  idp_auth_url                   = "https://example.com/saml"
+  idp_relay_state_parameter_name = "RelayState"
+  name                           = %[1]q
+  service_role                   = aws_iam_role.test.arn
+  subnet_ids                     = aws_subnet.test[*].id
+  user_role                      = aws_iam_role.test.arn
+  vpc_id                         = aws_vpc.test.id
+  workspace_security_group_id    = aws_security_group.test.id
+}
+`, rName))
+}
+
 func testAccStudioConfig_workspaceStorageEncryption(rName string) string {
 	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -5,6 +5,7 @@ package emrcontainers
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -40,6 +41,7 @@ func resourceVirtualCluster() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -135,6 +137,10 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(output.Id))
 
+	if _, err := waitVirtualClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EMR Containers Virtual Cluster (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceVirtualClusterRead(ctx, d, meta)...)
 }
 
@@ -266,6 +272,31 @@ func statusVirtualCluster(ctx context.Context, conn *emrcontainers.Client, id st
 	}
 }
 
+func waitVirtualClusterCreated(ctx context.Context, conn *emrcontainers.Client, id string, timeout time.Duration) (*awstypes.VirtualCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		// A new virtual cluster may not be found at all, or may briefly report ARRESTED, before it is RUNNING.
+		// An ARRESTED then RUNNING sequence therefore succeeds; only a cluster that stays ARRESTED times out.
+		Pending:        enum.Slice(awstypes.VirtualClusterStateArrested, awstypes.VirtualClusterStateTerminating),
+		Target:         enum.Slice(awstypes.VirtualClusterStateRunning),
+		Refresh:        statusVirtualCluster(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*awstypes.VirtualCluster); ok {
+		// Explain a timeout while still ARRESTED.
+		if v.State == awstypes.VirtualClusterStateArrested {
+			tfresource.SetLastError(err, errors.New("EMR on EKS cannot access the EKS cluster namespace, check that the namespace exists and that the service-linked role has been granted access"))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
 func waitVirtualClusterDeleted(ctx context.Context, conn *emrcontainers.Client, id string, timeout time.Duration) (*awstypes.VirtualCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.VirtualClusterStateTerminating),
@@ -7,11 +7,15 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrcontainers"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -62,13 +66,20 @@ func dataSourceVirtualCluster() *schema.Resource {
 					},
 				},
 			},
+			"container_provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{names.AttrName},
+			},
 			names.AttrCreatedAt: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			names.AttrName: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "virtual_cluster_id"},
 			},
 			names.AttrState: {
 				Type:     schema.TypeString,
@@ -77,7 +88,8 @@ func dataSourceVirtualCluster() *schema.Resource {
 			names.AttrTags: tftags.TagsSchemaComputed(),
 			"virtual_cluster_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -87,7 +99,19 @@ func dataSourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRContainersClient(ctx)
 
-	id := d.Get("virtual_cluster_id").(string)
+	var id string
+	if v, ok := d.GetOk("virtual_cluster_id"); ok {
+		id = v.(string)
+	} else {
+		vc, err := findVirtualClusterByTwoPartKey(ctx, conn, d.Get(names.AttrName).(string), d.Get("container_provider_id").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EMR Containers Virtual Cluster", err))
+		}
+
+		id = aws.ToString(vc.Id)
+	}
+
 	vc, err := findVirtualClusterByID(ctx, conn, id)
 
 	if err != nil {
@@ -112,3 +136,41 @@ func dataSourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+func findVirtualClusterByTwoPartKey(ctx context.Context, conn *emrcontainers.Client, name, containerProviderID string) (*awstypes.VirtualCluster, error) {
+	input := &emrcontainers.ListVirtualClustersInput{}
+	if containerProviderID != "" {
+		input.ContainerProviderId = aws.String(containerProviderID)
+	}
+
+	output, err := findVirtualClusters(ctx, conn, input, func(v *awstypes.VirtualCluster) bool {
+		return aws.ToString(v.Name) == name && v.State != awstypes.VirtualClusterStateTerminated
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findVirtualClusters(ctx context.Context, conn *emrcontainers.Client, input *emrcontainers.ListVirtualClustersInput, filter tfslices.Predicate[*awstypes.VirtualCluster]) ([]awstypes.VirtualCluster, error) {
+	var output []awstypes.VirtualCluster
+
+	pages := emrcontainers.NewListVirtualClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.VirtualClusters {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
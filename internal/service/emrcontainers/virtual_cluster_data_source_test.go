@@ -55,6 +55,43 @@ func TestAccEMRContainersVirtualClusterDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccEMRContainersVirtualClusterDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	dataSourceResourceName := "data.aws_emrcontainers_virtual_cluster.test"
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"kubernetes": {
+			Source:            "hashicorp/kubernetes",
+			VersionConstraint: "~> 2.3",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/emr-containers.amazonaws.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckVirtualClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterDataSourceConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "container_provider.0.id", dataSourceResourceName, "container_provider.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceResourceName, "virtual_cluster_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, dataSourceResourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, names.AttrState),
+				),
+			},
+		},
+	})
+}
+
 func testAccVirtualClusterDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccVirtualClusterConfig_basic(rName), `
 data "aws_emrcontainers_virtual_cluster" "test" {
@@ -62,3 +99,12 @@ data "aws_emrcontainers_virtual_cluster" "test" {
 }
 `)
 }
+
+func testAccVirtualClusterDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccVirtualClusterConfig_basic(rName), `
+data "aws_emrcontainers_virtual_cluster" "test" {
+  name                  = aws_emrcontainers_virtual_cluster.test.name
+  container_provider_id = aws_emrcontainers_virtual_cluster.test.container_provider[0].id
+}
+`)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -108,6 +110,11 @@ func resourceApplication() *schema.Resource {
 			"initial_capacity": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				// Worker types are unique, so key the set on the type alone. This avoids
+				// spurious diffs when the service fills in computed worker configuration.
+				Set: func(v interface{}) int {
+					return create.StringHashcode(v.(map[string]interface{})["initial_capacity_type"].(string))
+				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"initial_capacity_config": {
@@ -125,17 +132,29 @@ func resourceApplication() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"cpu": {
-													Type:     schema.TypeString,
-													Required: true,
+													Type:         schema.TypeString,
+													Required:     true,
+													StateFunc:    normalizeResourceSize("vCPU"),
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(vCPU|vcpu|VCPU)?$`), "must be a number of vCPUs, e.g. 2 vCPU"),
 												},
 												"disk": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													StateFunc:    normalizeResourceSize("GB"),
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(GB|gb|gB|Gb)$`), "must be a size in GB, e.g. 20 GB"),
+												},
+												"disk_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(workerDiskType_Values(), false),
 												},
 												"memory": {
-													Type:     schema.TypeString,
-													Required: true,
+													Type:         schema.TypeString,
+													Required:     true,
+													StateFunc:    normalizeResourceSize("GB"),
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(GB|gb|gB|Gb)?$`), "must be a size in GB, e.g. 10 GB"),
 												},
 											},
 										},
@@ -184,17 +203,132 @@ func resourceApplication() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cpu": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							StateFunc:    normalizeResourceSize("vCPU"),
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(vCPU|vcpu|VCPU)?$`), "must be a number of vCPUs, e.g. 2 vCPU"),
 						},
 						"disk": {
-							Type:     schema.TypeString,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							StateFunc:    normalizeResourceSize("GB"),
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(GB|gb|gB|Gb)$`), "must be a size in GB, e.g. 20 GB"),
+						},
+						"memory": {
+							Type:         schema.TypeString,
+							Required:     true,
+							StateFunc:    normalizeResourceSize("GB"),
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[1-9][0-9]*(\s)?(GB|gb|gB|Gb)?$`), "must be a size in GB, e.g. 10 GB"),
+						},
+					},
+				},
+			},
+			"monitoring_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrLogGroupName: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"log_stream_name_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"log_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrValues: {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"managed_persistence_monitoring_configuration": {
+							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
 						},
-						"memory": {
-							Type:     schema.TypeString,
-							Required: true,
+						"prometheus_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"remote_write_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+								},
+							},
+						},
+						"s3_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"log_uri": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://`), "must be an S3 URI"),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -231,6 +365,27 @@ func resourceApplication() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"scheduler_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_runs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"queue_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(15, 720),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
@@ -286,10 +441,20 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrNetworkConfiguration); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("scheduler_configuration"); ok && len(v.([]interface{})) > 0 {
+		// An empty block enables the scheduler with the service defaults.
+		tfMap, _ := v.([]interface{})[0].(map[string]interface{})
+		input.SchedulerConfiguration = expandSchedulerConfiguration(tfMap)
+	}
+
 	output, err := conn.CreateApplication(ctx, input)
 
 	if err != nil {
@@ -351,10 +516,18 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting maximum_capacity: %s", err)
 	}
 
+	if err := d.Set("monitoring_configuration", flattenMonitoringConfiguration(application.MonitoringConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting monitoring_configuration: %s", err)
+	}
+
 	if err := d.Set(names.AttrNetworkConfiguration, []interface{}{flattenNetworkConfiguration(application.NetworkConfiguration)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
 
+	if err := d.Set("scheduler_configuration", flattenSchedulerConfiguration(application.SchedulerConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduler_configuration: %s", err)
+	}
+
 	setTagsOut(ctx, application.Tags)
 
 	return diags
@@ -398,10 +571,23 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk(names.AttrNetworkConfiguration); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		if v, ok := d.GetOk("scheduler_configuration"); ok && len(v.([]interface{})) > 0 {
+			// An empty block enables the scheduler with the service defaults.
+			tfMap, _ := v.([]interface{})[0].(map[string]interface{})
+			input.SchedulerConfiguration = expandSchedulerConfiguration(tfMap)
+		} else if d.HasChange("scheduler_configuration") {
+			// An empty configuration in an update disables the scheduler.
+			input.SchedulerConfiguration = &types.SchedulerConfiguration{}
+		}
+
 		if v, ok := d.GetOk("release_label"); ok {
 			input.ReleaseLabel = aws.String(v.(string))
 		}
@@ -671,20 +857,280 @@ func flattenMaximumCapacity(apiObject *types.MaximumAllowedResources) map[string
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.Cpu; v != nil {
-		tfMap["cpu"] = aws.ToString(v)
+		tfMap["cpu"] = normalizeResourceSizeValue(aws.ToString(v), "vCPU")
 	}
 
 	if v := apiObject.Disk; v != nil {
-		tfMap["disk"] = aws.ToString(v)
+		tfMap["disk"] = normalizeResourceSizeValue(aws.ToString(v), "GB")
 	}
 
 	if v := apiObject.Memory; v != nil {
-		tfMap["memory"] = aws.ToString(v)
+		tfMap["memory"] = normalizeResourceSizeValue(aws.ToString(v), "GB")
 	}
 
 	return tfMap
 }
 
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *types.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logging_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLoggingConfiguration = expandCloudWatchLoggingConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_persistence_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedPersistenceMonitoringConfiguration = expandManagedPersistenceMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prometheus_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PrometheusMonitoringConfiguration = expandPrometheusMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3MonitoringConfiguration = expandS3MonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *types.MonitoringConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLoggingConfiguration; v != nil {
+		tfMap["cloudwatch_logging_configuration"] = []interface{}{flattenCloudWatchLoggingConfiguration(v)}
+	}
+
+	if v := apiObject.ManagedPersistenceMonitoringConfiguration; v != nil {
+		tfMap["managed_persistence_monitoring_configuration"] = []interface{}{flattenManagedPersistenceMonitoringConfiguration(v)}
+	}
+
+	if v := apiObject.PrometheusMonitoringConfiguration; v != nil {
+		tfMap["prometheus_monitoring_configuration"] = []interface{}{flattenPrometheusMonitoringConfiguration(v)}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{flattenS3MonitoringConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandCloudWatchLoggingConfiguration(tfMap map[string]interface{}) *types.CloudWatchLoggingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudWatchLoggingConfiguration{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+		apiObject.LogStreamNamePrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_types"].(*schema.Set); ok && v.Len() > 0 {
+		logTypes := make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			logTypes[tfMap[names.AttrName].(string)] = flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))
+		}
+
+		apiObject.LogTypes = logTypes
+	}
+
+	return apiObject
+}
+
+func flattenCloudWatchLoggingConfiguration(apiObject *types.CloudWatchLoggingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogGroupName; v != nil {
+		tfMap[names.AttrLogGroupName] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogStreamNamePrefix; v != nil {
+		tfMap["log_stream_name_prefix"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogTypes; len(v) > 0 {
+		var tfList []interface{}
+
+		for name, values := range v {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrName:   name,
+				names.AttrValues: values,
+			})
+		}
+
+		tfMap["log_types"] = tfList
+	}
+
+	return tfMap
+}
+
+func expandManagedPersistenceMonitoringConfiguration(tfMap map[string]interface{}) *types.ManagedPersistenceMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ManagedPersistenceMonitoringConfiguration{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenManagedPersistenceMonitoringConfiguration(apiObject *types.ManagedPersistenceMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandPrometheusMonitoringConfiguration(tfMap map[string]interface{}) *types.PrometheusMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PrometheusMonitoringConfiguration{}
+
+	if v, ok := tfMap["remote_write_url"].(string); ok && v != "" {
+		apiObject.RemoteWriteUrl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPrometheusMonitoringConfiguration(apiObject *types.PrometheusMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RemoteWriteUrl; v != nil {
+		tfMap["remote_write_url"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandS3MonitoringConfiguration(tfMap map[string]interface{}) *types.S3MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3MonitoringConfiguration{}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+		apiObject.LogUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3MonitoringConfiguration(apiObject *types.S3MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogUri; v != nil {
+		tfMap["log_uri"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandSchedulerConfiguration(tfMap map[string]interface{}) *types.SchedulerConfiguration {
+	apiObject := &types.SchedulerConfiguration{}
+
+	if v, ok := tfMap["max_concurrent_runs"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentRuns = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["queue_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.QueueTimeoutMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenSchedulerConfiguration(apiObject *types.SchedulerConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxConcurrentRuns; v != nil {
+		tfMap["max_concurrent_runs"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.QueueTimeoutMinutes; v != nil {
+		tfMap["queue_timeout_minutes"] = aws.ToInt32(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandNetworkConfiguration(tfMap map[string]interface{}) *types.NetworkConfiguration {
 	if tfMap == nil {
 		return nil
@@ -799,7 +1245,7 @@ func expandInitialCapacityConfig(tfMap map[string]interface{}) types.InitialCapa
 		apiObject.WorkerCount = aws.Int64(int64(v))
 	}
 
-	if v, ok := tfMap["worker_configuration"].([]interface{}); ok && v[0] != nil {
+	if v, ok := tfMap["worker_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.WorkerConfiguration = expandWorkerResourceConfig(v[0].(map[string]interface{}))
 	}
 
@@ -812,7 +1258,7 @@ func flattenInitialCapacityConfig(apiObject *types.InitialCapacityConfig) map[st
 	}
 
 	tfMap := map[string]interface{}{
-		"worker_count": apiObject.WorkerCount,
+		"worker_count": aws.ToInt64(apiObject.WorkerCount),
 	}
 
 	if v := apiObject.WorkerConfiguration; v != nil {
@@ -837,6 +1283,10 @@ func expandWorkerResourceConfig(tfMap map[string]interface{}) *types.WorkerResou
 		apiObject.Disk = aws.String(v)
 	}
 
+	if v, ok := tfMap["disk_type"].(string); ok && v != "" {
+		apiObject.DiskType = aws.String(v)
+	}
+
 	if v, ok := tfMap["memory"].(string); ok && v != "" {
 		apiObject.Memory = aws.String(v)
 	}
@@ -852,16 +1302,53 @@ func flattenWorkerResourceConfig(apiObject *types.WorkerResourceConfig) map[stri
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.Cpu; v != nil {
-		tfMap["cpu"] = aws.ToString(v)
+		tfMap["cpu"] = normalizeResourceSizeValue(aws.ToString(v), "vCPU")
 	}
 
 	if v := apiObject.Disk; v != nil {
-		tfMap["disk"] = aws.ToString(v)
+		tfMap["disk"] = normalizeResourceSizeValue(aws.ToString(v), "GB")
+	}
+
+	if v := apiObject.DiskType; v != nil {
+		tfMap["disk_type"] = aws.ToString(v)
 	}
 
 	if v := apiObject.Memory; v != nil {
-		tfMap["memory"] = aws.ToString(v)
+		tfMap["memory"] = normalizeResourceSizeValue(aws.ToString(v), "GB")
 	}
 
 	return tfMap
 }
+
+const (
+	workerDiskTypeShuffleOptimized = "SHUFFLE_OPTIMIZED"
+	workerDiskTypeStandard         = "STANDARD"
+)
+
+func workerDiskType_Values() []string {
+	return []string{
+		workerDiskTypeShuffleOptimized,
+		workerDiskTypeStandard,
+	}
+}
+
+var resourceSizeRegexp = regexache.MustCompile(`^\s*([0-9]+)\s*([A-Za-z]*)\s*$`)
+
+// normalizeResourceSizeValue returns the canonical "<n> <unit>" form of a worker
+// resource size (e.g. "2vCPU" => "2 vCPU", "10" => "10 GB"), matching what the API returns.
+// Values that can't be parsed are returned unchanged.
+func normalizeResourceSizeValue(s, unit string) string {
+	m := resourceSizeRegexp.FindStringSubmatch(s)
+
+	if m == nil || (m[2] != "" && !strings.EqualFold(m[2], unit)) {
+		return s
+	}
+
+	return m[1] + " " + unit
+}
+
+func normalizeResourceSize(unit string) schema.SchemaStateFunc {
+	return func(v interface{}) string {
+		return normalizeResourceSizeValue(v.(string), unit)
+	}
+}
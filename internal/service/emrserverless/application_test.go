@@ -177,6 +177,62 @@ func TestAccEMRServerlessApplication_initialCapacity(t *testing.T) {
 	})
 }
 
+func TestAccEMRServerlessApplication_initialCapacitySpark(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.Application
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_initialCapacitySpark(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "initial_capacity.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "initial_capacity.*", map[string]string{
+						"initial_capacity_type":                                   "Driver",
+						"initial_capacity_config.0.worker_count":                  "1",
+						"initial_capacity_config.0.worker_configuration.0.cpu":    "2 vCPU",
+						"initial_capacity_config.0.worker_configuration.0.memory": "4 GB",
+						"initial_capacity_config.0.worker_configuration.0.disk":   "20 GB",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "initial_capacity.*", map[string]string{
+						"initial_capacity_type":                                      "Executor",
+						"initial_capacity_config.0.worker_count":                     "1",
+						"initial_capacity_config.0.worker_configuration.0.cpu":       "4 vCPU",
+						"initial_capacity_config.0.worker_configuration.0.memory":    "8 GB",
+						"initial_capacity_config.0.worker_configuration.0.disk":      "40 GB",
+						"initial_capacity_config.0.worker_configuration.0.disk_type": "SHUFFLE_OPTIMIZED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.cpu", "16 vCPU"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.memory", "64 GB"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_initialCapacitySpark(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "initial_capacity.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "initial_capacity.*", map[string]string{
+						"initial_capacity_type":                  "Executor",
+						"initial_capacity_config.0.worker_count": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRServerlessApplication_imageConfiguration(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -287,6 +343,92 @@ func TestAccEMRServerlessApplication_interactiveConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccEMRServerlessApplication_schedulerConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.Application
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationConfig_schedulerConfiguration(rName, 0, 360),
+				ExpectError: regexache.MustCompile(`expected scheduler_configuration.0.max_concurrent_runs to be in the range \(1 - 1000\)`),
+			},
+			{
+				Config: testAccApplicationConfig_schedulerConfiguration(rName, 10, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.livy_endpoint_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.studio_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.0.max_concurrent_runs", "10"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.0.queue_timeout_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_schedulerConfiguration(rName, 20, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.0.max_concurrent_runs", "20"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.0.queue_timeout_minutes", "120"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_interactiveConfiguration(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplication_monitoringConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.Application
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_monitoringConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.log_group_name", "aws_cloudwatch_log_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.s3_monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.s3_monitoring_configuration.0.log_uri", fmt.Sprintf("s3://%s/logs/", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEMRServerlessApplication_maxCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var application types.Application
@@ -517,6 +659,50 @@ resource "aws_emrserverless_application" "test" {
 `, rName, cpu)
 }
 
+func testAccApplicationConfig_initialCapacitySpark(rName string, executorCount int) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-7.1.0"
+  type          = "spark"
+
+  initial_capacity {
+    initial_capacity_type = "Driver"
+
+    initial_capacity_config {
+      worker_count = 1
+
+      worker_configuration {
+        cpu    = "2vCPU"
+        memory = "4GB"
+        disk   = "20 GB"
+      }
+    }
+  }
+
+  initial_capacity {
+    initial_capacity_type = "Executor"
+
+    initial_capacity_config {
+      worker_count = %[2]d
+
+      worker_configuration {
+        cpu       = "4 vCPU"
+        memory    = "8 GB"
+        disk      = "40 GB"
+        disk_type = "SHUFFLE_OPTIMIZED"
+      }
+    }
+  }
+
+  maximum_capacity {
+    cpu    = "16vCPU"
+    memory = "64 gb"
+  }
+}
+`, rName, executorCount)
+}
+
 func testAccApplicationConfig_interactiveConfiguration(rName string, livyEndpointEnabled, studioEnabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_emrserverless_application" "test" {
@@ -531,6 +717,56 @@ resource "aws_emrserverless_application" "test" {
 `, rName, livyEndpointEnabled, studioEnabled)
 }
 
+func testAccApplicationConfig_schedulerConfiguration(rName string, maxConcurrentRuns, queueTimeoutMinutes int) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-7.1.0"
+  type          = "spark"
+
+  interactive_configuration {
+    livy_endpoint_enabled = true
+    studio_enabled        = true
+  }
+
+  scheduler_configuration {
+    max_concurrent_runs   = %[2]d
+    queue_timeout_minutes = %[3]d
+  }
+}
+`, rName, maxConcurrentRuns, queueTimeoutMinutes)
+}
+
+func testAccApplicationConfig_monitoringConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-7.1.0"
+  type          = "spark"
+
+  monitoring_configuration {
+    cloudwatch_logging_configuration {
+      enabled        = true
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+
+    s3_monitoring_configuration {
+      log_uri = "s3://${aws_s3_bucket.test.bucket}/logs/"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccApplicationConfig_maxCapacity(rName, cpu string) string {
 	return fmt.Sprintf(`
 resource "aws_emrserverless_application" "test" {
@@ -134,6 +134,45 @@ func TestAccGlobalAcceleratorCrossAccountAttachment_resources(t *testing.T) {
 	})
 }
 
+func TestAccGlobalAcceleratorCrossAccountAttachment_sharedEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_cross_account_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rAccountID := sdkacctest.RandStringFromCharSet(12, "012346789")
+	var v awstypes.Attachment
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCrossAccountAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCrossAccountAttachmentConfig_resourceEndpointAndCIDR(rName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccCrossAccountAttachmentConfig_sharedEndpoint(rName, rAccountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCrossAccountAttachmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "principals.*", rAccountID),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource.*.endpoint_id", "aws_lb.test", names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource.*", map[string]string{
+						names.AttrRegion: acctest.Region(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGlobalAcceleratorCrossAccountAttachment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_globalaccelerator_cross_account_attachment.test"
@@ -305,6 +344,33 @@ resource "aws_globalaccelerator_cross_account_attachment" "test" {
 `, rName))
 }
 
+func testAccCrossAccountAttachmentConfig_sharedEndpoint(rName, accountID string) string {
+	return acctest.ConfigCompose(testAccEndpointGroupConfig_baseALB(rName), fmt.Sprintf(`
+resource "aws_globalaccelerator_cross_account_attachment" "test" {
+  name       = %[1]q
+  principals = [%[2]q]
+
+  resource {
+    endpoint_id = aws_lb.test.id
+    region      = %[3]q
+  }
+}
+`, rName, accountID, acctest.Region()))
+}
+
+func testAccCrossAccountAttachmentConfig_resourceEndpointAndCIDR(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_cross_account_attachment" "test" {
+  name = %[1]q
+
+  resource {
+    cidr_block  = "192.0.2.0/24"
+    endpoint_id = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
+  }
+}
+`, rName)
+}
+
 func testAccCrossAccountAttachmentConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_globalaccelerator_cross_account_attachment" "test" {
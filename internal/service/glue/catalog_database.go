@@ -134,16 +134,21 @@ func ResourceCatalogDatabase() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrCatalogID: {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
 						},
 						names.AttrDatabaseName: {
 							Type:     schema.TypeString,
 							Required: true,
+							ForceNew: true,
 						},
 						names.AttrRegion: {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidRegionName,
 						},
 					},
 				},
@@ -237,6 +242,11 @@ func resourceCatalogDatabaseUpdate(ctx context.Context, d *schema.ResourceData,
 			dbInput.FederatedDatabase = expandDatabaseFederatedDatabase(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		// Resource links must keep their target, otherwise the update unlinks the database.
+		if v, ok := d.GetOk("target_database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			dbInput.TargetDatabase = expandDatabaseTargetDatabase(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("create_table_default_permission"); ok && len(v.([]interface{})) > 0 {
 			dbInput.CreateTableDefaultPermissions = expandDatabasePrincipalPermissions(v.([]interface{}))
 		}
@@ -302,7 +312,13 @@ func resourceCatalogDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("target_database", nil)
 	}
 
-	if err := d.Set("create_table_default_permission", flattenDatabasePrincipalPermissions(database.CreateTableDefaultPermissions)); err != nil {
+	createTableDefaultPermissions := flattenDatabasePrincipalPermissions(database.CreateTableDefaultPermissions)
+	// An empty create_table_default_permission block disables the default IAM_ALLOWED_PRINCIPALS permissions.
+	// The API returns no permissions in that case, so keep the empty block to avoid a perpetual diff.
+	if len(createTableDefaultPermissions) == 0 && isEmptyDatabasePrincipalPermissions(d.Get("create_table_default_permission").([]interface{})) {
+		createTableDefaultPermissions = []interface{}{map[string]interface{}{}}
+	}
+	if err := d.Set("create_table_default_permission", createTableDefaultPermissions); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting create_table_default_permission: %s", err)
 	}
 
@@ -430,12 +446,13 @@ func expandDatabasePrincipalPermissions(tfList []interface{}) []awstypes.Princip
 		return nil
 	}
 
-	var apiObjects []awstypes.PrincipalPermissions
+	// A non-nil empty slice is sent as an empty list, which removes the default permissions.
+	apiObjects := []awstypes.PrincipalPermissions{}
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if !ok {
+		if !ok || isEmptyDatabasePrincipalPermission(tfMap) {
 			continue
 		}
 
@@ -447,6 +464,32 @@ func expandDatabasePrincipalPermissions(tfList []interface{}) []awstypes.Princip
 	return apiObjects
 }
 
+func isEmptyDatabasePrincipalPermissions(tfList []interface{}) bool {
+	if len(tfList) == 0 {
+		return false
+	}
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && !isEmptyDatabasePrincipalPermission(tfMap) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isEmptyDatabasePrincipalPermission(tfMap map[string]interface{}) bool {
+	if v, ok := tfMap[names.AttrPermissions].(*schema.Set); ok && v.Len() > 0 {
+		return false
+	}
+
+	if v, ok := tfMap[names.AttrPrincipal].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return false
+	}
+
+	return true
+}
+
 func expandDatabasePrincipalPermission(tfMap map[string]interface{}) awstypes.PrincipalPermissions {
 	apiObject := awstypes.PrincipalPermissions{}
 
@@ -121,6 +121,52 @@ func TestAccGlueCatalogDatabase_createTablePermission(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogDatabase_createTablePermissionEmpty(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_glue_catalog_database.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogDatabaseConfig_permissionEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.0.permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.0.principal.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_table_default_permission"},
+			},
+			{
+				Config: testAccCatalogDatabaseConfig_permission(rName, "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "create_table_default_permission.0.permissions.*", "ALL"),
+				),
+			},
+			{
+				Config: testAccCatalogDatabaseConfig_permissionEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permission.0.permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogDatabase_targetDatabase(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_glue_catalog_database.test"
@@ -159,6 +205,16 @@ func TestAccGlueCatalogDatabase_targetDatabase(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "target_database.0.region", ""),
 				),
 			},
+			{
+				Config: testAccCatalogDatabaseConfig_targetDescription(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "target_database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database.0.catalog_id", "aws_glue_catalog_database.test2", names.AttrCatalogID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database.0.database_name", "aws_glue_catalog_database.test2", names.AttrName),
+				),
+			},
 		},
 	})
 }
@@ -480,6 +536,25 @@ resource "aws_glue_catalog_database" "test2" {
 `, rName)
 }
 
+func testAccCatalogDatabaseConfig_targetDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  target_database {
+    catalog_id    = aws_glue_catalog_database.test2.catalog_id
+    database_name = aws_glue_catalog_database.test2.name
+  }
+}
+
+resource "aws_glue_catalog_database" "test2" {
+  name         = "%[1]s-2"
+  location_uri = "my-location"
+}
+`, rName, description)
+}
+
 func testAccCatalogDatabaseConfig_targetWithRegion(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -516,6 +591,16 @@ resource "aws_glue_catalog_database" "test" {
 `, rName, permission)
 }
 
+func testAccCatalogDatabaseConfig_permissionEmpty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  create_table_default_permission {}
+}
+`, rName)
+}
+
 func testAccCatalogDatabaseConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
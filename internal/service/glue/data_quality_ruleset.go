@@ -67,9 +67,12 @@ func ResourceDataQualityRuleset() *schema.Resource {
 				Computed: true,
 			},
 			"ruleset": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 65536),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 65536),
+					validation.StringIsNotWhiteSpace,
+				),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -164,7 +167,7 @@ func resourceDataQualityRulesetRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("created_on", dataQualityRuleset.CreatedOn.Format(time.RFC3339))
 	d.Set(names.AttrName, dataQualityRuleset.Name)
 	d.Set(names.AttrDescription, dataQualityRuleset.Description)
-	d.Set("last_modified_on", dataQualityRuleset.CreatedOn.Format(time.RFC3339))
+	d.Set("last_modified_on", dataQualityRuleset.LastModifiedOn.Format(time.RFC3339))
 	d.Set("recommendation_run_id", dataQualityRuleset.RecommendationRunId)
 	d.Set("ruleset", dataQualityRuleset.Ruleset)
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -86,12 +87,32 @@ func TestAccGlueDataQualityRuleset_updateRuleset(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDataQualityRulesetExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ruleset", updatedRuleset),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_on"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccGlueDataQualityRuleset_emptyRuleset(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataQualityRulesetConfig_basic(rName, "   "),
+				ExpectError: regexache.MustCompile(`expected "ruleset" to not be an empty string or whitespace`),
+			},
+		},
+	})
+}
+
 func TestAccGlueDataQualityRuleset_updateDescription(t *testing.T) {
 	ctx := acctest.Context(t)
 
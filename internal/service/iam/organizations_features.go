@@ -68,6 +68,19 @@ func (r *organizationsFeaturesResource) Create(ctx context.Context, request reso
 		return
 	}
 
+	// Fail fast if the caller is not the Organizations management account or a delegated administrator for IAM.
+	if _, err := findOrganizationsFeatures(ctx, conn); err != nil && !tfresource.NotFound(err) {
+		if errs.IsA[*awstypes.AccountNotManagementOrDelegatedAdministratorException](err) {
+			response.Diagnostics.AddError("creating IAM Organizations Features", "the calling account must be the Organizations management account or a delegated administrator for IAM: "+err.Error())
+
+			return
+		}
+
+		response.Diagnostics.AddError("reading IAM Organizations Features", err.Error())
+
+		return
+	}
+
 	if err := updateOrganizationFeatures(ctx, conn, enabledFeatures, []awstypes.FeatureType{}); err != nil {
 		response.Diagnostics.AddError("creating IAM Organizations Features", err.Error())
 
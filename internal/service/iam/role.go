@@ -175,6 +175,22 @@ func resourceRole() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"role_last_used": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_used_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"unique_id": {
@@ -307,6 +323,9 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	} else {
 		d.Set("permissions_boundary", nil)
 	}
+	if err := d.Set("role_last_used", flattenRoleLastUsed(role.RoleLastUsed)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting role_last_used: %s", err)
+	}
 	d.Set("unique_id", role.RoleId)
 
 	assumeRolePolicy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
@@ -400,6 +419,11 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			MaxSessionDuration: aws.Int32(int32(d.Get("max_session_duration").(int))),
 		}
 
+		// UpdateRole clears the role's description if it is omitted.
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
 		_, err := conn.UpdateRole(ctx, input)
 
 		if err != nil {
@@ -373,6 +373,32 @@ func TestAccIAMRole_diffsCondition(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_assumeRolePolicyEquivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_assumeRolePolicyPrincipals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &conf),
+				),
+			},
+			{
+				Config:   testAccRoleConfig_assumeRolePolicyPrincipalsReordered(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_badJSON(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -493,6 +519,45 @@ func TestAccIAMRole_maxSessionDuration(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_MaxSessionDuration_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_maxSessionDurationDescription(rName, 3700),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test Role"),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "3700"),
+					resource.TestCheckResourceAttr(resourceName, "role_last_used.#", "1"),
+				),
+			},
+			{
+				Config: testAccRoleConfig_maxSessionDurationDescription(rName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test Role"),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "role_last_used.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_permissionsBoundary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var role awstypes.Role
@@ -1254,6 +1319,56 @@ func testAccCheckRolePolicyRemoveInlinePolicy(ctx context.Context, role *awstype
 	}
 }
 
+func testAccRoleConfig_assumeRolePolicyPrincipals(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = [
+          "ec2.${data.aws_partition.current.dns_suffix}",
+          "lambda.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+      Effect = "Allow"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRoleConfig_assumeRolePolicyPrincipalsReordered(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {
+      "Service": [
+        "lambda.${data.aws_partition.current.dns_suffix}",
+        "ec2.${data.aws_partition.current.dns_suffix}"
+      ]
+    },
+    "Action": ["sts:AssumeRole"]
+  },
+  "Version": "2012-10-17"
+}
+EOF
+}
+`, rName)
+}
+
 func testAccRoleConfig_maxSessionDuration(rName string, maxSessionDuration int) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -1278,6 +1393,31 @@ resource "aws_iam_role" "test" {
 `, rName, maxSessionDuration)
 }
 
+func testAccRoleConfig_maxSessionDurationDescription(rName string, maxSessionDuration int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name                 = %[1]q
+  description          = "Test Role"
+  path                 = "/"
+  max_session_duration = %[2]d
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+      Sid    = ""
+    }]
+  })
+}
+`, rName, maxSessionDuration)
+}
+
 func testAccRoleConfig_permissionsBoundary(rName, permissionsBoundary string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
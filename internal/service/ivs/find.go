@@ -60,6 +60,29 @@ func FindRecordingConfigurationByID(ctx context.Context, conn *ivs.Client, id st
 	return out.RecordingConfiguration, nil
 }
 
+func FindPlaybackRestrictionPolicyByID(ctx context.Context, conn *ivs.Client, id string) (*awstypes.PlaybackRestrictionPolicy, error) {
+	in := &ivs.GetPlaybackRestrictionPolicyInput{
+		Arn: aws.String(id),
+	}
+	out, err := conn.GetPlaybackRestrictionPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PlaybackRestrictionPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.PlaybackRestrictionPolicy, nil
+}
+
 func FindChannelByID(ctx context.Context, conn *ivs.Client, arn string) (*awstypes.Channel, error) {
 	in := &ivs.GetChannelInput{
 		Arn: aws.String(arn),
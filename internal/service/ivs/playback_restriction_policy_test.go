@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSPlaybackRestrictionPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVS)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, "0"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ivs", regexache.MustCompile(`playback-restriction-policy/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.PlaybackRestrictionPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVS)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_countries(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "US"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "CA"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_origins(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v2),
+					testAccCheckPlaybackRestrictionPolicyNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "GB"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVS)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVS)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivs.ResourcePlaybackRestrictionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_invalidCountry(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVS)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlaybackRestrictionPolicyConfig_invalidCountry(),
+				ExpectError: regexache.MustCompile(`must be an ISO 3166-1 alpha-2 country code`),
+			},
+		},
+	})
+}
+
+func testAccCheckPlaybackRestrictionPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivs_playback_restriction_policy" {
+				continue
+			}
+
+			_, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.IVS, create.ErrActionCheckingDestroyed, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyExists(ctx context.Context, name string, policy *awstypes.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSClient(ctx)
+
+		output, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *output
+
+		return nil
+	}
+}
+
+func testAccPlaybackRestrictionPolicyPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSClient(ctx)
+
+	input := &ivs.ListPlaybackRestrictionPoliciesInput{}
+	_, err := conn.ListPlaybackRestrictionPolicies(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyNotRecreated(before, after *awstypes.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Arn), aws.ToString(after.Arn); before != after {
+			return create.Error(names.IVS, create.ErrActionCheckingNotRecreated, tfivs.ResNamePlaybackRestrictionPolicy, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPlaybackRestrictionPolicyConfig_basic() string {
+	return `
+resource "aws_ivs_playback_restriction_policy" "test" {
+}
+`
+}
+
+func testAccPlaybackRestrictionPolicyConfig_countries(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name              = %[1]q
+  allowed_countries = ["US", "CA"]
+}
+`, rName)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_origins(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name                             = %[1]q
+  allowed_countries                = ["GB"]
+  allowed_origins                  = ["https://example.com"]
+  enable_strict_origin_enforcement = true
+}
+`, rName)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_invalidCountry() string {
+	return `
+resource "aws_ivs_playback_restriction_policy" "test" {
+  allowed_countries = ["USA"]
+}
+`
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
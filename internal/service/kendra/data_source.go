@@ -15,17 +15,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/document"
 	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -162,6 +165,26 @@ func ResourceDataSource() *schema.Resource {
 								},
 							},
 						},
+						"template_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"template": {
+										Type:                  schema.TypeString,
+										Required:              true,
+										ValidateFunc:          validation.StringIsJSON,
+										DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+										DiffSuppressOnRefresh: true,
+										StateFunc: func(v interface{}) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+								},
+							},
+						},
 						"web_crawler_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -459,6 +482,10 @@ func ResourceDataSource() *schema.Resource {
 			names.AttrSchedule: {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexache.MustCompile(`^cron\(\S+( \S+){5}\)$`),
+					"must be a cron expression with six fields, for example cron(0 12 * * ? *)",
+				),
 			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
@@ -606,7 +633,12 @@ func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk(names.AttrConfiguration); ok {
-		input.Configuration = expandDataSourceConfiguration(v.([]interface{}))
+		configuration, err := expandDataSourceConfiguration(v.([]interface{}))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Configuration = configuration
 	}
 
 	if v, ok := d.GetOk("custom_document_enrichment_configuration"); ok {
@@ -710,7 +742,12 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set(names.AttrType, resp.Type)
 	d.Set("updated_at", aws.ToTime(resp.UpdatedAt).Format(time.RFC3339))
 
-	if err := d.Set(names.AttrConfiguration, flattenDataSourceConfiguration(resp.Configuration)); err != nil {
+	configuration, err := flattenDataSourceConfiguration(resp.Configuration)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := d.Set(names.AttrConfiguration, configuration); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -738,7 +775,12 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange(names.AttrConfiguration) {
-			input.Configuration = expandDataSourceConfiguration(d.Get(names.AttrConfiguration).([]interface{}))
+			configuration, err := expandDataSourceConfiguration(d.Get(names.AttrConfiguration).([]interface{}))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.Configuration = configuration
 		}
 
 		if d.HasChange("custom_document_enrichment_configuration") {
@@ -906,14 +948,14 @@ func statusDataSource(ctx context.Context, conn *kendra.Client, id, indexId stri
 	}
 }
 
-func expandDataSourceConfiguration(tfList []interface{}) *types.DataSourceConfiguration {
+func expandDataSourceConfiguration(tfList []interface{}) (*types.DataSourceConfiguration, error) {
 	if len(tfList) == 0 || tfList[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	tfMap, ok := tfList[0].(map[string]interface{})
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
 	result := &types.DataSourceConfiguration{}
@@ -922,11 +964,41 @@ func expandDataSourceConfiguration(tfList []interface{}) *types.DataSourceConfig
 		result.S3Configuration = expandS3Configuration(v)
 	}
 
+	if v, ok := tfMap["template_configuration"].([]interface{}); ok && len(v) > 0 {
+		templateConfiguration, err := expandTemplateConfiguration(v)
+		if err != nil {
+			return nil, err
+		}
+
+		result.TemplateConfiguration = templateConfiguration
+	}
+
 	if v, ok := tfMap["web_crawler_configuration"].([]interface{}); ok && len(v) > 0 {
 		result.WebCrawlerConfiguration = expandWebCrawlerConfiguration(v)
 	}
 
-	return result
+	return result, nil
+}
+
+// Template Configuration
+func expandTemplateConfiguration(tfList []interface{}) (*types.TemplateConfiguration, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	template, err := tfjson.SmithyDocumentFromString(tfMap["template"].(string), document.NewLazyDocument)
+	if err != nil {
+		return nil, fmt.Errorf("decoding template_configuration template: %w", err)
+	}
+
+	return &types.TemplateConfiguration{
+		Template: template,
+	}, nil
 }
 
 // S3 Configuration
@@ -1341,9 +1413,9 @@ func expandDocumentAttributeValue(tfList []interface{}) *types.DocumentAttribute
 	return result
 }
 
-func flattenDataSourceConfiguration(apiObject *types.DataSourceConfiguration) []interface{} {
+func flattenDataSourceConfiguration(apiObject *types.DataSourceConfiguration) ([]interface{}, error) {
 	if apiObject == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := map[string]interface{}{}
@@ -1352,11 +1424,38 @@ func flattenDataSourceConfiguration(apiObject *types.DataSourceConfiguration) []
 		m["s3_configuration"] = flattenS3Configuration(v)
 	}
 
+	if v := apiObject.TemplateConfiguration; v != nil {
+		templateConfiguration, err := flattenTemplateConfiguration(v)
+		if err != nil {
+			return nil, err
+		}
+
+		m["template_configuration"] = templateConfiguration
+	}
+
 	if v := apiObject.WebCrawlerConfiguration; v != nil {
 		m["web_crawler_configuration"] = flattenWebCrawlerConfiguration(v)
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
+}
+
+// Template Configuration
+func flattenTemplateConfiguration(apiObject *types.TemplateConfiguration) ([]interface{}, error) {
+	if apiObject == nil || apiObject.Template == nil {
+		return nil, nil
+	}
+
+	template, err := tfjson.SmithyDocumentToString(apiObject.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{
+		"template": template,
+	}
+
+	return []interface{}{m}, nil
 }
 
 // S3 Configuration
@@ -34,6 +34,11 @@ func resourceStreamConsumer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -76,7 +81,7 @@ func resourceStreamConsumerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(output.Consumer.ConsumerARN))
 
-	if _, err := waitStreamConsumerCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Stream Consumer (%s) create: %s", d.Id(), err)
 	}
 
@@ -100,7 +105,11 @@ func resourceStreamConsumerRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set(names.AttrARN, consumer.ConsumerARN)
-	d.Set("creation_timestamp", aws.ToTime(consumer.ConsumerCreationTimestamp).Format(time.RFC3339))
+	if v := consumer.ConsumerCreationTimestamp; v != nil {
+		d.Set("creation_timestamp", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("creation_timestamp", nil)
+	}
 	d.Set(names.AttrName, consumer.ConsumerName)
 	d.Set(names.AttrStreamARN, consumer.StreamARN)
 
@@ -124,7 +133,7 @@ func resourceStreamConsumerDelete(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Stream Consumer (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitStreamConsumerDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Stream Consumer (%s) delete: %s", d.Id(), err)
 	}
 
@@ -172,10 +181,7 @@ func statusStreamConsumer(ctx context.Context, conn *kinesis.Client, arn string)
 	}
 }
 
-func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn string) (*types.ConsumerDescription, error) {
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn string, timeout time.Duration) (*types.ConsumerDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ConsumerStatusCreating),
 		Target:  enum.Slice(types.ConsumerStatusActive),
@@ -192,10 +198,7 @@ func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn st
 	return nil, err
 }
 
-func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Client, arn string) (*types.ConsumerDescription, error) {
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Client, arn string, timeout time.Duration) (*types.ConsumerDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ConsumerStatusDeleting),
 		Target:  []string{},
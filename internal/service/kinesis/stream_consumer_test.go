@@ -38,7 +38,7 @@ func TestAccKinesisStreamConsumer_basic(t *testing.T) {
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "kinesis", regexache.MustCompile(fmt.Sprintf("stream/%[1]s/consumer/%[1]s", rName))),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamName, names.AttrARN),
-					resource.TestCheckResourceAttrSet(resourceName, "creation_timestamp"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_timestamp"),
 				),
 			},
 			{
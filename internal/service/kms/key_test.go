@@ -484,6 +484,7 @@ func TestAccKMSKey_rotation(t *testing.T) {
 					testAccCheckKeyExists(ctx, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, "is_enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period_in_days", "365"),
 				),
 			},
 			{
@@ -494,6 +495,11 @@ func TestAccKMSKey_rotation(t *testing.T) {
 			},
 			{
 				Config: testAccKeyConfig_enabledRotationPeriod(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKeyExists(ctx, resourceName, &key2),
 					resource.TestCheckResourceAttr(resourceName, "is_enabled", acctest.CtTrue),
@@ -514,6 +520,24 @@ func TestAccKMSKey_rotation(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_rotationPeriodWithoutRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_disabledRotationPeriod(rName),
+				ExpectError: regexache.MustCompile(`"rotation_period_in_days" can only be set when "enable_key_rotation" is true`),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/26174.
 func TestAccKMSKey_tags_IgnoreTags_ModifyOutOfBand(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1236,6 +1260,17 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_disabledRotationPeriod(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = false
+  rotation_period_in_days = 91
+}
+`, rName)
+}
+
 func testAccKeyConfig_disabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
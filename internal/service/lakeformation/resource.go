@@ -5,6 +5,8 @@ package lakeformation
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -29,6 +31,8 @@ func ResourceResource() *schema.Resource {
 		ReadWithoutTimeout:   resourceResourceRead,
 		DeleteWithoutTimeout: resourceResourceDelete,
 
+		CustomizeDiff: resourceResourceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:         schema.TypeString,
@@ -155,6 +159,26 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourceResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	useServiceLinkedRole := config.GetAttr("use_service_linked_role")
+	if !useServiceLinkedRole.IsKnown() || useServiceLinkedRole.IsNull() || !useServiceLinkedRole.True() {
+		return nil
+	}
+
+	if v := config.GetAttr(names.AttrRoleARN); !v.IsKnown() || !v.IsNull() {
+		return fmt.Errorf("%s must not be set when use_service_linked_role is true", names.AttrRoleARN)
+	}
+
+	// Federated resources must be registered with a user-defined role.
+	if v := config.GetAttr("with_federation"); v.IsKnown() && !v.IsNull() && v.True() {
+		return errors.New("use_service_linked_role must not be true when with_federation is true")
+	}
+
+	return nil
+}
+
 func FindResourceByARN(ctx context.Context, conn *lakeformation.Client, arn string) (*awstypes.ResourceInfo, error) {
 	input := &lakeformation.DescribeResourceInput{
 		ResourceArn: aws.String(arn),
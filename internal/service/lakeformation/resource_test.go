@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -191,12 +192,35 @@ func TestAccLakeFormationResource_hybridAccessEnabled(t *testing.T) {
 					testAccCheckResourceExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, bucketResourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "hybrid_access_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "with_federation", acctest.CtFalse),
 				),
 			},
 		},
 	})
 }
 
+func TestAccLakeFormationResource_serviceLinkedRoleConflicts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceConfig_serviceLinkedRoleWithRoleARN(rName),
+				ExpectError: regexache.MustCompile(`role_arn must not be set when use_service_linked_role is true`),
+			},
+			{
+				Config:      testAccResourceConfig_serviceLinkedRoleWithFederation(rName),
+				ExpectError: regexache.MustCompile(`use_service_linked_role must not be true when with_federation is true`),
+			},
+		},
+	})
+}
+
 // AWS does not support changing from an IAM role to an SLR. No error is thrown
 // but the registration is not changed (the IAM role continues in the registration).
 //
@@ -322,6 +346,38 @@ resource "aws_lakeformation_resource" "test" {
 `, rName)
 }
 
+func testAccResourceConfig_serviceLinkedRoleWithRoleARN(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_lakeformation_resource" "test" {
+  arn                     = aws_s3_bucket.test.arn
+  role_arn                = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  use_service_linked_role = true
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
+func testAccResourceConfig_serviceLinkedRoleWithFederation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_lakeformation_resource" "test" {
+  arn                     = aws_s3_bucket.test.arn
+  use_service_linked_role = true
+  with_federation         = true
+}
+`, rName)
+}
+
 func testAccResourceConfig_hybridAccessEnabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
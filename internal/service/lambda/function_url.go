@@ -36,7 +36,11 @@ func resourceFunctionURL() *schema.Resource {
 		DeleteWithoutTimeout: resourceFunctionURLDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_permission", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -112,6 +116,11 @@ func resourceFunctionURL() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"skip_permission": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"url_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,27 +159,9 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(id)
 
-	if authorizationType == awstypes.FunctionUrlAuthTypeNone {
-		input := &lambda.AddPermissionInput{
-			Action:              aws.String("lambda:InvokeFunctionUrl"),
-			FunctionName:        aws.String(name),
-			FunctionUrlAuthType: authorizationType,
-			Principal:           aws.String("*"),
-			StatementId:         aws.String("FunctionURLAllowPublicAccess"),
-		}
-
-		if qualifier != "" {
-			input.Qualifier = aws.String(qualifier)
-		}
-
-		_, err := conn.AddPermission(ctx, input)
-
-		if err != nil {
-			if errs.IsAErrorMessageContains[*awstypes.ResourceConflictException](err, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
-				log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
-			} else {
-				return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
-			}
+	if authorizationType == awstypes.FunctionUrlAuthTypeNone && !d.Get("skip_permission").(bool) {
+		if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
 		}
 	}
 
@@ -259,10 +250,24 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.InvokeMode = awstypes.InvokeMode(d.Get("invoke_mode").(string))
 	}
 
-	_, err = conn.UpdateFunctionUrlConfig(ctx, input)
+	if d.HasChangesExcept("skip_permission") {
+		_, err = conn.UpdateFunctionUrlConfig(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Lambda Function URL (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function URL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("skip_permission") && awstypes.FunctionUrlAuthType(d.Get("authorization_type").(string)) == awstypes.FunctionUrlAuthTypeNone {
+		if d.Get("skip_permission").(bool) {
+			if err := removeFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing Lambda Function URL (%s) permission %s", d.Id(), err)
+			}
+		} else {
+			if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL (%s) permission %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceFunctionURLRead(ctx, d, meta)...)
@@ -299,6 +304,50 @@ func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+const functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+
+func addFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Client, name, qualifier string) error {
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: awstypes.FunctionUrlAuthTypeNone,
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.AddPermission(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.ResourceConflictException](err, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
+		log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Client, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.RemovePermission(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
 func findFunctionURLByTwoPartKey(ctx context.Context, conn *lambda.Client, name, qualifier string) (*lambda.GetFunctionUrlConfigOutput, error) {
 	input := &lambda.GetFunctionUrlConfigInput{
 		FunctionName: aws.String(name),
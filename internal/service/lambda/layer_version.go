@@ -35,10 +35,15 @@ func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
 		ReadWithoutTimeout:   resourceLayerVersionRead,
+		UpdateWithoutTimeout: resourceLayerVersionUpdate,
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set(names.AttrSkipDestroy, false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,7 +135,6 @@ func resourceLayerVersion() *schema.Resource {
 			names.AttrSkipDestroy: {
 				Type:     schema.TypeBool,
 				Default:  false,
-				ForceNew: true,
 				Optional: true,
 			},
 			"source_code_hash": {
@@ -256,6 +260,14 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceLayerVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only skip_destroy can be updated in place and it is not sent to AWS.
+
+	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
+}
+
 func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -6,6 +6,7 @@ package lexv2models
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -270,6 +271,16 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 		!plan.Name.Equal(state.Name) ||
 		!plan.VoiceSettings.Equal(state.VoiceSettings) ||
 		!plan.NluIntentCOnfidenceThreshold.Equal(state.NluIntentCOnfidenceThreshold) {
+		// Updating a built locale resets its status, so rebuild it afterwards.
+		previous, err := FindBotLocaleByID(ctx, conn, plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, plan.LocaleID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
 		in := &lexmodelsv2.UpdateBotLocaleInput{
 			BotId:                        plan.BotID.ValueStringPointer(),
 			BotVersion:                   plan.BotVersion.ValueStringPointer(),
@@ -290,7 +301,7 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 			in.VoiceSettings = expandVoiceSettings(ctx, tfList)
 		}
 
-		_, err := conn.UpdateBotLocale(ctx, in)
+		_, err = conn.UpdateBotLocale(ctx, in)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, plan.LocaleID.String(), err),
@@ -315,6 +326,17 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 			)
 			return
 		}
+
+		if previous.BotLocaleStatus == awstypes.BotLocaleStatusBuilt && out.BotLocaleStatus != awstypes.BotLocaleStatusBuilt {
+			if err := buildBotLocale(ctx, conn, plan.Id.ValueString(), updateTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameBotLocale, plan.LocaleID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
 		state.refreshFromOutput(ctx, out)
 	}
 
@@ -401,6 +423,28 @@ func waitBotLocaleUpdated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 	return nil, err
 }
 
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotLocaleStatusNotBuilt, awstypes.BotLocaleStatusBuilding, awstypes.BotLocaleStatusReadyExpressTesting),
+		Target:                    enum.Slice(awstypes.BotLocaleStatusBuilt),
+		Refresh:                   statusBotLocale(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if out.BotLocaleStatus == awstypes.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.BotLocaleStatusDeleting),
@@ -432,6 +476,34 @@ func statusBotLocale(ctx context.Context, conn *lexmodelsv2.Client, id string) r
 	}
 }
 
+// buildBotLocale builds the specified bot locale, if it is not already built, and waits for the build to complete.
+func buildBotLocale(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) error {
+	out, err := FindBotLocaleByID(ctx, conn, id)
+	if err != nil {
+		return err
+	}
+
+	switch out.BotLocaleStatus {
+	case awstypes.BotLocaleStatusBuilt:
+		return nil
+	case awstypes.BotLocaleStatusBuilding:
+	default:
+		_, err := conn.BuildBotLocale(ctx, &lexmodelsv2.BuildBotLocaleInput{
+			BotId:      out.BotId,
+			BotVersion: out.BotVersion,
+			LocaleId:   out.LocaleId,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = waitBotLocaleBuilt(ctx, conn, id, timeout)
+
+	return err
+}
+
 func FindBotLocaleByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
 	parts, err := fwflex.ExpandResourceId(id, botLocaleIDPartCount, false)
 	if err != nil {
@@ -6,6 +6,7 @@ package lexv2models
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -97,6 +98,7 @@ func (r *resourceBotVersion) Schema(ctx context.Context, req resource.SchemaRequ
 
 const (
 	botVersionIDPartCount = 2
+	botVersionDraft       = "DRAFT"
 )
 
 func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,6 +116,34 @@ func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+
+	// A bot version can only be created from built locales.
+	for localeID, v := range localeSpec {
+		if v.SourceBotVersion.ValueString() != botVersionDraft {
+			continue
+		}
+
+		localeIDParts := []string{localeID, plan.BotID.ValueString(), botVersionDraft}
+		id, err := fwflex.FlattenResourceId(localeIDParts, botLocaleIDPartCount, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotVersion, plan.BotID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if err := buildBotLocale(ctx, conn, id, createTimeout); err != nil {
+			err = fmt.Errorf("building Bot Locale (%s): %w", id, err)
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotVersion, plan.BotID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	in := &lexmodelsv2.CreateBotVersionInput{
 		BotId:                         plan.BotID.ValueStringPointer(),
 		BotVersionLocaleSpecification: expandLocalSpecification(localeSpec),
@@ -157,7 +187,6 @@ func (r *resourceBotVersion) Create(ctx context.Context, req resource.CreateRequ
 	state.Id = types.StringValue(id)
 	state.BotVersion = flex.StringToFramework(ctx, out.BotVersion)
 
-	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
 	_, err = waitBotVersionCreated(ctx, conn, state.Id.ValueString(), createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -87,6 +88,61 @@ func TestAccLexV2ModelsBotVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsBotVersion_builtLocale(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var botversion lexmodelsv2.DescribeBotVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotVersionExists(ctx, resourceName, &botversion),
+					testAccCheckBotLocaleStatus(ctx, "aws_lexv2models_bot_locale.test", string(awstypes.BotLocaleStatusBuilt)),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.en_US.source_bot_version", "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBotLocaleStatus(ctx context.Context, name, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotLocale, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		out, err := tflexv2models.FindBotLocaleByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotLocale, rs.Primary.ID, err)
+		}
+
+		if got := string(out.BotLocaleStatus); got != status {
+			return fmt.Errorf("Bot Locale (%s) status = %s, want %s", rs.Primary.ID, got, status)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBotVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -156,6 +212,17 @@ resource "aws_lexv2models_bot_locale" "test" {
   n_lu_intent_confidence_threshold = 0.7
 }
 
+resource "aws_lexv2models_intent" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  name        = %[1]q
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  sample_utterance {
+    utterance = "hello"
+  }
+}
+
 resource "aws_lexv2models_bot_version" "test" {
   bot_id = aws_lexv2models_bot.test.id
   locale_specification = {
@@ -163,6 +230,8 @@ resource "aws_lexv2models_bot_version" "test" {
       source_bot_version = "DRAFT"
     }
   }
+
+  depends_on = [aws_lexv2models_intent.test]
 }
 `, rName))
 }
@@ -18,6 +18,7 @@ func TestAccLogs_serial(t *testing.T) {
 			acctest.CtDisappears: testAccDelivery_disappears,
 			"tags":               testAccDelivery_tags,
 			"update":             testAccDelivery_update,
+			"wafS3":              testAccDelivery_wafS3,
 		},
 		"DeliverySource": {
 			acctest.CtBasic:      testAccDeliverySource_basic,
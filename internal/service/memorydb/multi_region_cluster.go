@@ -108,9 +108,6 @@ func (r *multiRegionClusterResource) Schema(ctx context.Context, request resourc
 			},
 			"node_type": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"num_shards": schema.Int64Attribute{
 				Computed: true,
@@ -378,6 +375,7 @@ func (r *multiRegionClusterResource) Delete(ctx context.Context, req resource.De
 			create.ProblemStandardMessage(names.MemoryDB, create.ErrActionDeleting, ResNameMultiRegionCluster, state.MultiRegionClusterName.String(), err),
 			err.Error(),
 		)
+		return
 	}
 
 	if aws.ToString(output.Status) != clusterStatusAvailable {
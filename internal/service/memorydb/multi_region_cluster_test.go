@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -279,6 +280,11 @@ func TestAccMemoryDBMultiRegionCluster_numShards(t *testing.T) {
 			},
 			{
 				Config: testAccMultiRegionClusterConfig_numShards(rName, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMultiRegionClusterExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "num_shards", "3"),
@@ -315,6 +321,11 @@ func TestAccMemoryDBMultiRegionCluster_nodeType(t *testing.T) {
 			},
 			{
 				Config: testAccMultiRegionClusterConfig_nodeType(rName, "db.r7g.2xlarge"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMultiRegionClusterExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "node_type", "db.r7g.2xlarge"),
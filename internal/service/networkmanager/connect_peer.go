@@ -176,6 +176,7 @@ func resourceConnectPeer() *schema.Resource {
 			"subnet_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 500),
 					validation.StringMatch(regexache.MustCompile(`^arn:[^:]{1,63}:ec2:[^:]{0,63}:[^:]{0,63}:subnet\/subnet-[0-9a-f]{8,17}$|^$`), "Must be a valid subnet ARN"),
@@ -284,9 +285,15 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta i
 		Resource:  fmt.Sprintf("connect-peer/%s", d.Id()),
 	}.String()
 	d.Set(names.AttrARN, arn)
-	bgpOptions := map[string]interface{}{}
-	bgpOptions["peer_asn"] = connectPeer.Configuration.BgpConfigurations[0].PeerAsn
-	d.Set("bgp_options", []interface{}{bgpOptions})
+	if connectPeer.Configuration != nil && len(connectPeer.Configuration.BgpConfigurations) > 0 {
+		bgpOptions := map[string]interface{}{}
+		bgpOptions["peer_asn"] = aws.ToInt64(connectPeer.Configuration.BgpConfigurations[0].PeerAsn)
+		if err := d.Set("bgp_options", []interface{}{bgpOptions}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting bgp_options: %s", err)
+		}
+	} else {
+		d.Set("bgp_options", nil)
+	}
 	d.Set(names.AttrConfiguration, []interface{}{flattenPeerConfiguration(connectPeer.Configuration)})
 	d.Set("connect_peer_id", connectPeer.ConnectPeerId)
 	d.Set("core_network_id", connectPeer.CoreNetworkId)
@@ -297,8 +304,10 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.Set("edge_location", connectPeer.EdgeLocation)
 	d.Set("connect_attachment_id", connectPeer.ConnectAttachmentId)
-	d.Set("inside_cidr_blocks", connectPeer.Configuration.InsideCidrBlocks)
-	d.Set("peer_address", connectPeer.Configuration.PeerAddress)
+	if connectPeer.Configuration != nil {
+		d.Set("inside_cidr_blocks", connectPeer.Configuration.InsideCidrBlocks)
+		d.Set("peer_address", connectPeer.Configuration.PeerAddress)
+	}
 	d.Set("subnet_arn", connectPeer.SubnetArn)
 	d.Set(names.AttrState, connectPeer.State)
 
@@ -446,6 +455,8 @@ func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.Client, id
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.ConnectPeer); ok {
+		tfresource.SetLastError(err, connectPeersError(output.LastModificationErrors))
+
 		return output, err
 	}
 
@@ -464,6 +475,8 @@ func waitConnectPeerDeleted(ctx context.Context, conn *networkmanager.Client, id
 	outputRaw, err := stateconf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.ConnectPeer); ok {
+		tfresource.SetLastError(err, connectPeersError(output.LastModificationErrors))
+
 		return output, err
 	}
 
@@ -191,25 +191,11 @@ func resourceCoreNetworkCreate(ctx context.Context, d *schema.ResourceData, meta
 	// this creates the core network with a starting policy document set to LIVE
 	// this is required for the first terraform apply if there attachments to the core network
 	if _, ok := d.GetOk("create_base_policy"); ok {
-		// if user supplies a full base_policy_document for maximum flexibility, use it. Otherwise, use regions list
-		// var policyDocumentTarget string
-		if v, ok := d.GetOk("base_policy_document"); ok {
-			input.PolicyDocument = aws.String(v.(string))
-		} else {
-			// if user supplies a region or multiple regions use it in the base policy, otherwise use current region
-			regions := []interface{}{meta.(*conns.AWSClient).Region(ctx)}
-			if v, ok := d.GetOk("base_policy_region"); ok {
-				regions = []interface{}{v.(string)}
-			} else if v, ok := d.GetOk("base_policy_regions"); ok && v.(*schema.Set).Len() > 0 {
-				regions = v.(*schema.Set).List()
-			}
-
-			policyDocumentTarget, err := buildCoreNetworkBasePolicyDocument(regions)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "Formatting Core Network Base Policy: %s", err)
-			}
-			input.PolicyDocument = aws.String(policyDocumentTarget)
+		policyDocumentTarget, err := expandCoreNetworkBasePolicyDocument(ctx, d, meta)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "Formatting Core Network Base Policy: %s", err)
 		}
+		input.PolicyDocument = aws.String(policyDocumentTarget)
 	}
 
 	output, err := conn.CreateCoreNetwork(ctx, input)
@@ -287,21 +273,13 @@ func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("create_base_policy") {
 		if _, ok := d.GetOk("create_base_policy"); ok {
-			// if user supplies a region or multiple regions use it in the base policy, otherwise use current region
-			regions := []interface{}{meta.(*conns.AWSClient).Region(ctx)}
-			if v, ok := d.GetOk("base_policy_region"); ok {
-				regions = []interface{}{v.(string)}
-			} else if v, ok := d.GetOk("base_policy_regions"); ok && v.(*schema.Set).Len() > 0 {
-				regions = v.(*schema.Set).List()
-			}
-
-			policyDocumentTarget, err := buildCoreNetworkBasePolicyDocument(regions)
+			policyDocumentTarget, err := expandCoreNetworkBasePolicyDocument(ctx, d, meta)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "Formatting Core Network Base Policy: %s", err)
 			}
 
-			err = putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), policyDocumentTarget)
+			err = putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), policyDocumentTarget, d.Timeout(schema.TimeoutUpdate))
 
 			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
@@ -527,7 +505,7 @@ func flattenCoreNetworkSegments(apiObjects []awstypes.CoreNetworkSegment) []inte
 	return tfList
 }
 
-func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Client, coreNetworkId, policyDocument string) error {
+func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Client, coreNetworkId, policyDocument string, timeout time.Duration) error {
 	document, err := structure.NormalizeJsonString(policyDocument)
 
 	if err != nil {
@@ -555,7 +533,11 @@ func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Cl
 		PolicyVersionId: policyVersionID,
 	})
 	if err != nil {
-		return fmt.Errorf("executing Network Manager Core Network (%s) change set (%d): %s", coreNetworkId, policyVersionID, err)
+		return fmt.Errorf("executing Network Manager Core Network (%s) change set (%d): %s", coreNetworkId, aws.ToInt32(policyVersionID), err)
+	}
+
+	if _, err := waitCoreNetworkPolicyExecuted(ctx, conn, coreNetworkId, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("waiting for Network Manager Core Network (%s) change set (%d) execute: %s", coreNetworkId, aws.ToInt32(policyVersionID), err)
 	}
 
 	return nil
@@ -587,10 +569,6 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Clie
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
-		return output, err
-	}
-
 	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
 		if state, v := output.ChangeSetState, output.PolicyErrors; state == awstypes.ChangeSetStateFailedGeneration && len(v) > 0 {
 			var errs []error
@@ -608,6 +586,41 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Clie
 	return nil, err
 }
 
+func waitCoreNetworkPolicyExecuted(ctx context.Context, conn *networkmanager.Client, coreNetworkId string, policyVersionId *int32, timeout time.Duration) (*awstypes.CoreNetworkPolicy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ChangeSetStateReadyToExecute, awstypes.ChangeSetStateExecuting),
+		Target:  enum.Slice(awstypes.ChangeSetStateExecutionSucceeded),
+		Timeout: timeout,
+		Refresh: statusCoreNetworkPolicyState(ctx, conn, coreNetworkId, policyVersionId),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandCoreNetworkBasePolicyDocument returns the base policy document to apply when create_base_policy is set.
+// If a full base_policy_document is supplied it is used as-is, otherwise one is built from the configured regions.
+func expandCoreNetworkBasePolicyDocument(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("base_policy_document"); ok {
+		return v.(string), nil
+	}
+
+	// if user supplies a region or multiple regions use it in the base policy, otherwise use current region
+	regions := []interface{}{meta.(*conns.AWSClient).Region(ctx)}
+	if v, ok := d.GetOk("base_policy_region"); ok {
+		regions = []interface{}{v.(string)}
+	} else if v, ok := d.GetOk("base_policy_regions"); ok && v.(*schema.Set).Len() > 0 {
+		regions = v.(*schema.Set).List()
+	}
+
+	return buildCoreNetworkBasePolicyDocument(regions)
+}
+
 // buildCoreNetworkBasePolicyDocument returns a base policy document
 func buildCoreNetworkBasePolicyDocument(regions []interface{}) (string, error) {
 	edgeLocations := make([]*coreNetworkPolicyCoreNetworkEdgeLocation, len(regions))
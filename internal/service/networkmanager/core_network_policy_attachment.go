@@ -60,6 +60,10 @@ func resourceCoreNetworkPolicyAttachment() *schema.Resource {
 					return json
 				},
 			},
+			"policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,6 +104,7 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 
 	if tfresource.NotFound(err) {
 		d.Set("policy_document", nil)
+		d.Set("policy_version_id", nil)
 	} else if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
 	} else {
@@ -110,6 +115,7 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 		}
 
 		d.Set("policy_document", encodedPolicyDocument)
+		d.Set("policy_version_id", coreNetworkPolicy.PolicyVersionId)
 	}
 	return diags
 }
@@ -120,7 +126,7 @@ func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.Re
 	conn := meta.(*conns.AWSClient).NetworkManagerClient(ctx)
 
 	if d.HasChange("policy_document") {
-		err := putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
+		err := putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string), d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
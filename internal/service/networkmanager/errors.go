@@ -58,3 +58,23 @@ func attachmentsError(apiObjects []awstypes.AttachmentError) error {
 
 	return errors.Join(errs...)
 }
+
+func connectPeerError(apiObject *awstypes.ConnectPeerError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
+}
+
+func connectPeersError(apiObjects []awstypes.ConnectPeerError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if err := connectPeerError(&apiObject); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", aws.ToString(apiObject.ResourceArn), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
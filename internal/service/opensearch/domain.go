@@ -555,14 +555,16 @@ func resourceDomain() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"hours": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 23),
 												},
 												"minutes": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 59),
 												},
 											},
 										},
@@ -1088,7 +1090,9 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChange("off_peak_window_options") {
-			input.OffPeakWindowOptions = expandOffPeakWindowOptions(d.Get("off_peak_window_options").([]interface{})[0].(map[string]interface{}))
+			if v, ok := d.Get("off_peak_window_options").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				input.OffPeakWindowOptions = expandOffPeakWindowOptions(v[0].(map[string]interface{}))
+			}
 		}
 
 		if d.HasChange("snapshot_options") {
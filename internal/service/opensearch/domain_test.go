@@ -1726,6 +1726,28 @@ func TestAccOpenSearchDomain_offPeakWindowOptions(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchDomain_offPeakWindowOptionsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccRandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig_offPeakWindowOptions(rName, 24, 0),
+				ExpectError: regexache.MustCompile(`expected off_peak_window_options.0.off_peak_window.0.window_start_time.0.hours to be in the range \(0 - 23\)`),
+			},
+			{
+				Config:      testAccDomainConfig_offPeakWindowOptions(rName, 0, 60),
+				ExpectError: regexache.MustCompile(`expected off_peak_window_options.0.off_peak_window.0.window_start_time.0.minutes to be in the range \(0 - 59\)`),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchDomain_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
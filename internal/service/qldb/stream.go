@@ -46,16 +46,18 @@ func resourceStream() *schema.Resource {
 				Computed: true,
 			},
 			"exclusive_end_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
 			},
 			"inclusive_start_time": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
 			},
 			"kinesis_configuration": {
 				Type:     schema.TypeList,
@@ -93,6 +95,10 @@ func resourceStream() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"stream_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -188,6 +194,7 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	d.Set("ledger_name", stream.LedgerName)
 	d.Set(names.AttrRoleARN, stream.RoleArn)
+	d.Set(names.AttrStatus, stream.Status)
 	d.Set("stream_name", stream.StreamName)
 
 	return diags
@@ -202,6 +209,11 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
 
+	// A bounded stream that has reached its exclusive end time cannot be canceled.
+	if types.StreamStatus(d.Get(names.AttrStatus).(string)) == types.StreamStatusCompleted {
+		return diags
+	}
+
 	ledgerName := d.Get("ledger_name").(string)
 	input := &qldb.CancelJournalKinesisStreamInput{
 		LedgerName: aws.String(ledgerName),
@@ -241,8 +253,9 @@ func findStreamByTwoPartKey(ctx context.Context, conn *qldb.Client, ledgerName,
 	}
 
 	// See https://docs.aws.amazon.com/qldb/latest/developerguide/streams.create.html#streams.create.states.
+	// A COMPLETED stream is a bounded stream that successfully reached its exclusive end time.
 	switch status := output.Status; status {
-	case types.StreamStatusCompleted, types.StreamStatusCanceled, types.StreamStatusFailed:
+	case types.StreamStatusCanceled, types.StreamStatusFailed:
 		return nil, &retry.NotFoundError{
 			Message:     string(status),
 			LastRequest: input,
@@ -296,7 +309,7 @@ func statusStreamCreated(ctx context.Context, conn *qldb.Client, ledgerName, str
 func waitStreamCreated(ctx context.Context, conn *qldb.Client, ledgerName, streamID string, timeout time.Duration) (*types.JournalKinesisStreamDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.StreamStatusImpaired),
-		Target:     enum.Slice(types.StreamStatusActive),
+		Target:     enum.Slice(types.StreamStatusActive, types.StreamStatusCompleted),
 		Refresh:    statusStreamCreated(ctx, conn, ledgerName, streamID),
 		Timeout:    timeout,
 		MinTimeout: 3 * time.Second,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
@@ -44,6 +45,7 @@ func TestAccQLDBStream_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "kinesis_configuration.0.stream_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "ledger_name"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrRoleARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.StreamStatusActive)),
 					resource.TestCheckResourceAttr(resourceName, "stream_name", rName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
@@ -138,6 +140,43 @@ func TestAccQLDBStream_withEndTime(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.0.aggregation_enabled", acctest.CtFalse),
 					resource.TestCheckResourceAttrSet(resourceName, "kinesis_configuration.0.stream_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQLDBStream_boundedCompleted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JournalKinesisStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_stream.test"
+	endTime := time.Now().UTC().Add(2 * time.Minute).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConfig_exclusiveEndTime(rName, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", endTime),
+				),
+			},
+			{
+				PreConfig: func() {
+					// Wait for the stream to reach its exclusive end time.
+					time.Sleep(3 * time.Minute)
+				},
+				Config: testAccStreamConfig_exclusiveEndTime(rName, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", endTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.StreamStatusCompleted)),
 				),
 			},
 		},
@@ -178,7 +217,7 @@ func testAccCheckStreamDestroy(ctx context.Context) resource.TestCheckFunc {
 				continue
 			}
 
-			_, err := tfqldb.FindStreamByTwoPartKey(ctx, conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+			output, err := tfqldb.FindStreamByTwoPartKey(ctx, conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -188,6 +227,11 @@ func testAccCheckStreamDestroy(ctx context.Context) resource.TestCheckFunc {
 				return err
 			}
 
+			// Completed streams cannot be canceled.
+			if output.Status == types.StreamStatusCompleted {
+				continue
+			}
+
 			return fmt.Errorf("QLDB Stream %s still exists", rs.Primary.ID)
 		}
 
@@ -275,6 +319,22 @@ resource "aws_qldb_stream" "test" {
 `, rName))
 }
 
+func testAccStreamConfig_exclusiveEndTime(rName, exclusiveEndTime string) string {
+	return acctest.ConfigCompose(testAccStreamBaseConfig(rName), fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  stream_name          = %[1]q
+  ledger_name          = aws_qldb_ledger.test.id
+  exclusive_end_time   = %[2]q
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+  role_arn             = aws_iam_role.test.arn
+
+  kinesis_configuration {
+    stream_arn = aws_kinesis_stream.test.arn
+  }
+}
+`, rName, exclusiveEndTime))
+}
+
 func testAccStreamConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccStreamBaseConfig(rName), fmt.Sprintf(`
 resource "aws_qldb_stream" "test" {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -113,6 +114,11 @@ func sweepStreams(region string) error {
 				}
 
 				for _, v := range page.Streams {
+					switch v.Status {
+					case types.StreamStatusCompleted, types.StreamStatusCanceled, types.StreamStatusFailed:
+						continue
+					}
+
 					r := resourceStream()
 					d := r.Data(nil)
 					d.SetId(aws.ToString(v.StreamId))
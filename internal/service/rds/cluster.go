@@ -610,6 +610,10 @@ func resourceCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"storage_throughput": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrStorageType: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1387,6 +1391,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("serverlessv2_scaling_configuration", nil)
 	}
 	d.Set(names.AttrStorageEncrypted, dbc.StorageEncrypted)
+	d.Set("storage_throughput", dbc.StorageThroughput)
 	d.Set(names.AttrStorageType, dbc.StorageType)
 	d.Set(names.AttrVPCSecurityGroupIDs, tfslices.ApplyToAll(dbc.VpcSecurityGroups, func(v types.VpcSecurityGroupMembership) string {
 		return aws.ToString(v.VpcSecurityGroupId)
@@ -570,6 +570,7 @@ func TestAccRDSCluster_storageTypeIo1(t *testing.T) {
 				Config: testAccClusterConfig_storageType(rName, "io1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_throughput"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStorageType, "io1"),
 				),
 			},
@@ -597,6 +598,7 @@ func TestAccRDSCluster_storageTypeIo2(t *testing.T) {
 				Config: testAccClusterConfig_storageType(rName, "io2"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_throughput"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStorageType, "io2"),
 				),
 			},
@@ -778,6 +780,7 @@ func TestAccRDSCluster_allocatedStorage_gp3(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
 					resource.TestCheckResourceAttr(resourceName, names.AttrAllocatedStorage, "400"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_throughput"),
 				),
 			},
 			{
@@ -98,7 +98,7 @@ func resourceReservedInstance() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"recurring_charge_amount": {
-							Type:     schema.TypeInt,
+							Type:     schema.TypeFloat,
 							Computed: true,
 						},
 						"recurring_charge_frequency": {
@@ -194,7 +194,7 @@ func resourceReservedInstanceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("product_description", reservation.ProductDescription)
 	d.Set("recurring_charges", flattenRecurringCharges(reservation.RecurringCharges))
 	d.Set("reservation_id", reservation.ReservedDBInstanceId)
-	d.Set(names.AttrStartTime, reservation.StartTime.Format(time.RFC3339))
+	d.Set(names.AttrStartTime, aws.ToTime(reservation.StartTime).Format(time.RFC3339))
 	d.Set(names.AttrState, reservation.State)
 	d.Set("usage_price", reservation.UsagePrice)
 
@@ -54,10 +54,10 @@ func TestAccRDSReservedInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "offering_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "product_description", resourceName, "product_description"),
-					resource.TestCheckResourceAttrSet(resourceName, "recurring_charges"),
+					resource.TestCheckResourceAttrSet(resourceName, "recurring_charges.#"),
 					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "active"),
 					resource.TestCheckResourceAttrSet(resourceName, "usage_price"),
 				),
 			},
@@ -5,12 +5,14 @@ package route53
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,7 +44,7 @@ func resourceQueryLog() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validQueryLogCloudWatchLogGroupARN,
 			},
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -121,6 +123,35 @@ func resourceQueryLogDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// validQueryLogCloudWatchLogGroupARN checks that the log group is in us-east-1.
+// Route53 only delivers query logs for public hosted zones, and only to log groups in that region.
+func validQueryLogCloudWatchLogGroupARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	parsedARN, err := arn.Parse(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, v, err))
+		return ws, errors
+	}
+
+	if parsedARN.Service != "logs" {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a CloudWatch Logs log group ARN", k, v))
+	}
+
+	// Query logging log groups must be in us-east-1 in the commercial partition.
+	// Other partitions have their own Route 53 control plane regions.
+	if parsedARN.Partition == endpoints.AwsPartitionID && parsedARN.Region != endpoints.UsEast1RegionID {
+		errors = append(errors, fmt.Errorf("%q (%s) must be in the %s region, got: %s", k, v, endpoints.UsEast1RegionID, parsedARN.Region))
+	}
+
+	return ws, errors
+}
+
 func findQueryLoggingConfigByID(ctx context.Context, conn *route53.Client, id string) (*awstypes.QueryLoggingConfig, error) {
 	input := &route53.GetQueryLoggingConfigInput{
 		Id: aws.String(id),
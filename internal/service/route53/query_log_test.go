@@ -111,6 +111,27 @@ func TestAccRoute53QueryLog_Disappears_hostedZone(t *testing.T) {
 	})
 }
 
+func TestAccRoute53QueryLog_logGroupRegionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryLogConfig_logGroupARN("arn:aws:logs:us-west-2:123456789012:log-group:/aws/route53/example.com"), //lintignore:AWSAT003,AWSAT005
+				ExpectError: regexache.MustCompile(`must be in the us-east-1 region, got: us-west-2`),
+			},
+			{
+				Config:      testAccQueryLogConfig_logGroupARN("arn:aws:s3:::example"), //lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`must be a CloudWatch Logs log group ARN`),
+			},
+		},
+	})
+}
+
 func testAccCheckQueryLogExists(ctx context.Context, n string, v *awstypes.QueryLoggingConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -200,3 +221,12 @@ resource "aws_route53_query_log" "test" {
 }
 `, rName, domainName)
 }
+
+func testAccQueryLogConfig_logGroupARN(logGroupARN string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_query_log" "test" {
+  cloudwatch_log_group_arn = %[1]q
+  zone_id                  = "Z0000000000000000000"
+}
+`, logGroupARN)
+}
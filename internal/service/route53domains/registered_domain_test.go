@@ -303,6 +303,43 @@ func testAccRegisteredDomain_transferLock(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "transfer_lock", acctest.CtTrue),
 				),
 			},
+			{
+				Config: testAccRegisteredDomainConfig_transferLock(domainName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegisteredDomain_techContact(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfig_techContact(domainName, "Prague", "119 01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.0.city", "Prague"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.0.zip_code", "119 01"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfig_techContact(domainName, "Brno", "602 00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.0.city", "Brno"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.0.zip_code", "602 00"),
+				),
+			},
 		},
 	})
 }
@@ -537,3 +574,23 @@ resource "aws_route53domains_registered_domain" "test" {
 }
 `, domainName, transferLock)
 }
+
+func testAccRegisteredDomainConfig_techContact(domainName, city, zipCode string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tech_contact {
+    address_line_1 = "The Castle"
+    city           = %[2]q
+    contact_type   = "PERSON"
+    country_code   = "CZ"
+    email          = "terraform-acctest+aws-route53domains-test3@hashicorp.com"
+    first_name     = "Franz"
+    last_name      = "Kafka"
+    phone_number   = "+420.224372434"
+    zip_code       = %[3]q
+  }
+}
+`, domainName, city, zipCode)
+}
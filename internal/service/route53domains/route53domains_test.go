@@ -23,6 +23,7 @@ func TestAccRoute53Domains_serial(t *testing.T) {
 			"contacts":       testAccRegisteredDomain_contacts,
 			"contactPrivacy": testAccRegisteredDomain_contactPrivacy,
 			"nameservers":    testAccRegisteredDomain_nameservers,
+			"techContact":    testAccRegisteredDomain_techContact,
 			"transferLock":   testAccRegisteredDomain_transferLock,
 		},
 		"DelegationSignerRecord": {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -47,7 +48,8 @@ func TestAccRoute53ProfilesAssociation_basic(t *testing.T) {
 					testAccCheckAssociationExists(ctx, resourceName, &association),
 					resource.TestCheckResourceAttrPair(resourceName, "profile_id", profileName, names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceID, vpcName, names.AttrID),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "route53profiles", regexache.MustCompile(`profile-association/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProfileStatusComplete)),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwnerID),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatusMessage),
 				),
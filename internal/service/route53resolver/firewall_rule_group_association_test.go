@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -39,6 +40,7 @@ func TestAccRoute53ResolverFirewallRuleGroupAssociation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "firewall_rule_group_id", "aws_route53_resolver_firewall_rule_group.test", names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, "mutation_protection", string(awstypes.MutationProtectionStatusDisabled)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "101"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FirewallRuleGroupAssociationStatusComplete)),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
@@ -135,6 +137,14 @@ func TestAccRoute53ResolverFirewallRuleGroupAssociation_priority(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckFirewallRuleGroupAssociationDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallRuleGroupAssociationConfig_priority(rName, 99),
+				ExpectError: regexache.MustCompile(`expected priority to be in the range \(100 - 9900\), got 99`),
+			},
+			{
+				Config:      testAccFirewallRuleGroupAssociationConfig_priority(rName, 9901),
+				ExpectError: regexache.MustCompile(`expected priority to be in the range \(100 - 9900\), got 9901`),
+			},
 			{
 				Config: testAccFirewallRuleGroupAssociationConfig_priority(rName, 101),
 				Check: resource.ComposeTestCheckFunc(
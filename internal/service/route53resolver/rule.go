@@ -265,6 +265,27 @@ func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v i
 		}
 	}
 
+	if v := diff.GetRawConfig().GetAttr("target_ip"); v.IsKnown() && !v.IsNull() {
+		for _, tfObj := range v.AsValueSlice() {
+			ip, ipv6 := tfObj.GetAttr("ip"), tfObj.GetAttr("ipv6")
+
+			// Skip validation until both addresses are known.
+			if !ip.IsWhollyKnown() || !ipv6.IsWhollyKnown() {
+				continue
+			}
+
+			hasIP, hasIPv6 := !ip.IsNull() && ip.AsString() != "", !ipv6.IsNull() && ipv6.AsString() != ""
+
+			if !hasIP && !hasIPv6 {
+				return errors.New(`each "target_ip" block must specify one of "ip" or "ipv6"`)
+			}
+
+			if hasIP && hasIPv6 {
+				return errors.New(`each "target_ip" block must specify only one of "ip" or "ipv6"`)
+			}
+		}
+	}
+
 	return nil
 }
 
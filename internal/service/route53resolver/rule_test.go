@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -263,6 +265,11 @@ func TestAccRoute53ResolverRule_forward(t *testing.T) {
 			},
 			{
 				Config: testAccRuleConfig_forwardTargetIPChanged(rName, domainName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRuleExists(ctx, resourceName, &rule2),
 					testAccCheckRulesSame(&rule2, &rule1),
@@ -305,6 +312,29 @@ func TestAccRoute53ResolverRule_forward(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverRule_forwardTargetIPInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_forwardTargetIPNoAddress(rName, domainName),
+				ExpectError: regexache.MustCompile(`each "target_ip" block must specify one of "ip" or "ipv6"`),
+			},
+			{
+				Config:      testAccRuleConfig_forwardTargetIPBothAddresses(rName, domainName),
+				ExpectError: regexache.MustCompile(`each "target_ip" block must specify only one of "ip" or "ipv6"`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverRule_forwardMultiProtocol(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule awstypes.ResolverRule
@@ -685,6 +715,35 @@ resource "aws_route53_resolver_rule" "test" {
 `, rName, domainName))
 }
 
+func testAccRuleConfig_forwardTargetIPNoAddress(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "FORWARD"
+  name        = %[1]q
+
+  target_ip {
+    port = 53
+  }
+}
+`, rName, domainName)
+}
+
+func testAccRuleConfig_forwardTargetIPBothAddresses(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "FORWARD"
+  name        = %[1]q
+
+  target_ip {
+    ip   = "192.0.2.6"
+    ipv6 = "2001:db8::6"
+  }
+}
+`, rName, domainName)
+}
+
 func testAccRuleConfig_forward_ipv6(rName, domainName string) string {
 	return acctest.ConfigCompose(testAccRuleConfig_resolverEndpointBaseIPv6(rName), fmt.Sprintf(`
 resource "aws_route53_resolver_rule" "test" {
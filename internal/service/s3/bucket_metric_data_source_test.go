@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetricDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_metric.test"
+	resourceName := "aws_s3_bucket_metric.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetricDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrBucket, resourceName, names.AttrBucket),
+					resource.TestCheckResourceAttrPair(dataSourceName, "filter.#", resourceName, "filter.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "filter.0.prefix", resourceName, "filter.0.prefix"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "filter.0.tags.%", resourceName, "filter.0.tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "filter.0.tags.tag1", resourceName, "filter.0.tags.tag1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetricDataSource_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketMetricDataSourceConfig_notFound(rName),
+				ExpectError: regexache.MustCompile(`no matching S3 Bucket Metric found`),
+			},
+		},
+	})
+}
+
+func testAccBucketMetricDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetricConfig_filterPrefixAndMultipleTags(rName, rName, "prefix/", "value1", "value2"), `
+data "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket_metric.test.bucket
+  name   = aws_s3_bucket_metric.test.name
+}
+`)
+}
+
+func testAccBucketMetricDataSourceConfig_notFound(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetricConfig_base(rName), fmt.Sprintf(`
+data "aws_s3_bucket_metric" "test" {
+  bucket = aws_s3_bucket.bucket.id
+  name   = %[1]q
+}
+`, rName))
+}
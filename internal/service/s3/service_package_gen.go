@@ -44,6 +44,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
 		},
+		{
+			Factory:  dataSourceBucketMetric,
+			TypeName: "aws_s3_bucket_metric",
+			Name:     "Bucket Metric",
+		},
 		{
 			Factory:  dataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -148,7 +148,7 @@ func (r *resourceTableBucketPolicy) Update(ctx context.Context, req resource.Upd
 	_, err := conn.PutTableBucketPolicy(ctx, &input)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.S3Tables, create.ErrActionCreating, ResNameTableBucketPolicy, plan.TableBucketARN.String(), err),
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionUpdating, ResNameTableBucketPolicy, plan.TableBucketARN.String(), err),
 			err.Error(),
 		)
 		return
@@ -157,7 +157,7 @@ func (r *resourceTableBucketPolicy) Update(ctx context.Context, req resource.Upd
 	out, err := findTableBucketPolicy(ctx, conn, plan.TableBucketARN.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.S3Tables, create.ErrActionCreating, ResNameTableBucketPolicy, plan.TableBucketARN.String(), err),
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionUpdating, ResNameTableBucketPolicy, plan.TableBucketARN.String(), err),
 			err.Error(),
 		)
 		return
@@ -92,6 +92,36 @@ func TestAccS3TablesTablePolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3TablesTablePolicy_crossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var tablepolicy s3tables.GetTablePolicyOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_s3tables_table_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTablePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTablePolicyConfig_crossAccount(rName, namespace, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTablePolicyExists(ctx, resourceName, &tablepolicy),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_policy"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTablePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
@@ -208,3 +238,55 @@ resource "aws_s3tables_table_bucket" "test" {
 data "aws_caller_identity" "current" {}
 `, rName, namespace, bucketName)
 }
+
+func testAccTablePolicyConfig_crossAccount(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_s3tables_table_policy" "test" {
+  resource_policy  = data.aws_iam_policy_document.test.json
+  name             = aws_s3tables_table.test.name
+  namespace        = aws_s3tables_table.test.namespace
+  table_bucket_arn = aws_s3tables_table.test.table_bucket_arn
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "s3tables:GetTable",
+      "s3tables:GetTableData",
+      "s3tables:GetTableMetadataLocation",
+    ]
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.alternate.account_id}:root"]
+    }
+    resources = [aws_s3tables_table.test.arn]
+  }
+}
+
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+}
+
+resource "aws_s3tables_namespace" "test" {
+  namespace        = %[2]q
+  table_bucket_arn = aws_s3tables_table_bucket.test.arn
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[3]q
+}
+
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+`, rName, namespace, bucketName))
+}
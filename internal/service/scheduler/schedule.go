@@ -44,6 +44,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -673,6 +675,36 @@ func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, group
 	return out, nil
 }
 
+func resourceScheduleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("flexible_time_window.0.mode") && d.NewValueKnown("flexible_time_window.0.maximum_window_in_minutes") {
+		mode := types.FlexibleTimeWindowMode(d.Get("flexible_time_window.0.mode").(string))
+		window := d.Get("flexible_time_window.0.maximum_window_in_minutes").(int)
+
+		switch mode {
+		case types.FlexibleTimeWindowModeFlexible:
+			if window == 0 {
+				return fmt.Errorf(`"flexible_time_window.0.maximum_window_in_minutes" is required when "flexible_time_window.0.mode" is %q`, mode)
+			}
+		case types.FlexibleTimeWindowModeOff:
+			if window != 0 {
+				return fmt.Errorf(`"flexible_time_window.0.maximum_window_in_minutes" must not be set when "flexible_time_window.0.mode" is %q`, mode)
+			}
+		}
+	}
+
+	if d.NewValueKnown(names.AttrScheduleExpression) {
+		if v := d.Get(names.AttrScheduleExpression).(string); !scheduleExpressionRegexp.MatchString(v) {
+			return fmt.Errorf(`"schedule_expression" (%s) must be an at(), rate(), or cron() expression (evaluated in timezone %q)`, v, d.Get("schedule_expression_timezone").(string))
+		}
+	}
+
+	return nil
+}
+
+// scheduleExpressionRegexp matches the one-time, rate-based and cron-based schedule expression formats.
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+var scheduleExpressionRegexp = regexache.MustCompile(`^(at\(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\)|rate\(\d+ (minutes?|hours?|days?)\)|cron\(\S+( \S+){5}\))$`)
+
 // ResourceScheduleIDFromARN constructs a string of the form "group_name/schedule_name"
 // from the given Schedule ARN.
 func ResourceScheduleIDFromARN(arn string) (id string, err error) {
@@ -450,6 +450,32 @@ func TestAccSchedulerSchedule_flexibleTimeWindow(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_flexibleTimeWindowValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMode(name, "FLEXIBLE"),
+				ExpectError: regexache.MustCompile(`"flexible_time_window.0.maximum_window_in_minutes" is required`),
+			},
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowOff(name, 10),
+				ExpectError: regexache.MustCompile(`"flexible_time_window.0.maximum_window_in_minutes" must not be set`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_groupName(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -666,6 +692,32 @@ func TestAccSchedulerSchedule_scheduleExpression(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_scheduleExpressionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_scheduleExpression(name, "rate(one hour)"),
+				ExpectError: regexache.MustCompile(`must be an at\(\), rate\(\), or cron\(\) expression`),
+			},
+			{
+				Config:      testAccScheduleConfig_scheduleExpression(name, "cron(0 8 * *)"),
+				ExpectError: regexache.MustCompile(`must be an at\(\), rate\(\), or cron\(\) expression`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_scheduleExpressionTimezone(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1772,6 +1824,55 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_flexibleTimeWindowMode(name, mode string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = %[2]q
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, mode),
+	)
+}
+
+func testAccScheduleConfig_flexibleTimeWindowOff(name string, window int) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    maximum_window_in_minutes = %[2]d
+    mode                      = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, window),
+	)
+}
+
 func testAccScheduleConfig_groupName(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
@@ -21,15 +21,23 @@ import (
 func TestAccSESV2ContactList_serial(t *testing.T) {
 	t.Parallel()
 
-	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic:      testAccContactList_basic,
-		acctest.CtDisappears: testAccContactList_disappears,
-		"tags":               testAccSESV2ContactList_tagsSerial,
-		"description":        testAccContactList_description,
-		"topic":              testAccContactList_topic,
+	// Only one contact list is allowed per account, so contacts are tested serially alongside contact lists.
+	testCases := map[string]map[string]func(t *testing.T){
+		"ContactList": {
+			acctest.CtBasic:      testAccContactList_basic,
+			acctest.CtDisappears: testAccContactList_disappears,
+			"tags":               testAccSESV2ContactList_tagsSerial,
+			"description":        testAccContactList_description,
+			"topic":              testAccContactList_topic,
+		},
+		"Contact": {
+			acctest.CtBasic:      testAccContact_basic,
+			acctest.CtDisappears: testAccContact_disappears,
+			"topicPreferences":   testAccContact_topicPreferences,
+		},
 	}
 
-	acctest.RunSerialTests1Level(t, testCases, 0)
+	acctest.RunSerialTests2Levels(t, testCases, 0)
 }
 
 func testAccContactList_basic(t *testing.T) {
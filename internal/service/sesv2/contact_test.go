@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccContact_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName, emailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_list_name", "aws_sesv2_contact_list.test", "contact_list_name"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_updated_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "topic_default_preferences.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic_default_preferences.*", map[string]string{
+						"subscription_status": "OPT_OUT",
+						"topic_name":          "topic1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "topic_preferences.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_all", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContact_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName, emailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsesv2.ResourceContact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContact_topicPreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact.test"
+	emailAddress := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_topicPreferences(rName, emailAddress, "OPT_IN", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "topic_preferences.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic_preferences.*", map[string]string{
+						"subscription_status": "OPT_IN",
+						"topic_name":          "topic1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_all", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactConfig_topicPreferences(rName, emailAddress, "OPT_OUT", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "topic_preferences.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic_preferences.*", map[string]string{
+						"subscription_status": "OPT_OUT",
+						"topic_name":          "topic1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_all", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContactDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sesv2_contact" {
+				continue
+			}
+
+			_, err := tfsesv2.FindContactByTwoPartKey(ctx, conn, rs.Primary.Attributes["contact_list_name"], rs.Primary.Attributes["email_address"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SESv2 Contact %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContactExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Client(ctx)
+
+		_, err := tfsesv2.FindContactByTwoPartKey(ctx, conn, rs.Primary.Attributes["contact_list_name"], rs.Primary.Attributes["email_address"])
+
+		return err
+	}
+}
+
+func testAccContactConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+
+  topic {
+    default_subscription_status = "OPT_OUT"
+    display_name                = "topic1"
+    topic_name                  = "topic1"
+  }
+}
+`, rName)
+}
+
+func testAccContactConfig_basic(rName, emailAddress string) string {
+	return acctest.ConfigCompose(testAccContactConfig_base(rName), fmt.Sprintf(`
+resource "aws_sesv2_contact" "test" {
+  contact_list_name = aws_sesv2_contact_list.test.contact_list_name
+  email_address     = %[1]q
+}
+`, emailAddress))
+}
+
+func testAccContactConfig_topicPreferences(rName, emailAddress, subscriptionStatus string, unsubscribeAll bool) string {
+	return acctest.ConfigCompose(testAccContactConfig_base(rName), fmt.Sprintf(`
+resource "aws_sesv2_contact" "test" {
+  contact_list_name = aws_sesv2_contact_list.test.contact_list_name
+  email_address     = %[1]q
+  unsubscribe_all   = %[3]t
+
+  topic_preferences {
+    subscription_status = %[2]q
+    topic_name          = "topic1"
+  }
+}
+`, emailAddress, subscriptionStatus, unsubscribeAll))
+}
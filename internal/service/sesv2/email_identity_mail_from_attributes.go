@@ -51,6 +51,10 @@ func resourceEmailIdentityMailFromAttributes() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"mail_from_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -114,9 +118,11 @@ func resourceEmailIdentityMailFromAttributesRead(ctx context.Context, d *schema.
 	if out.MailFromAttributes != nil {
 		d.Set("behavior_on_mx_failure", out.MailFromAttributes.BehaviorOnMxFailure)
 		d.Set("mail_from_domain", out.MailFromAttributes.MailFromDomain)
+		d.Set("mail_from_domain_status", out.MailFromAttributes.MailFromDomainStatus)
 	} else {
 		d.Set("behavior_on_mx_failure", nil)
 		d.Set("mail_from_domain", nil)
+		d.Set("mail_from_domain_status", nil)
 	}
 
 	return diags
@@ -31,6 +31,10 @@ func dataSourceEmailIdentityMailFromAttributes() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"mail_from_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -57,9 +61,11 @@ func dataSourceEmailIdentityMailFromAttributesRead(ctx context.Context, d *schem
 	if out.MailFromAttributes != nil {
 		d.Set("behavior_on_mx_failure", out.MailFromAttributes.BehaviorOnMxFailure)
 		d.Set("mail_from_domain", out.MailFromAttributes.MailFromDomain)
+		d.Set("mail_from_domain_status", out.MailFromAttributes.MailFromDomainStatus)
 	} else {
 		d.Set("behavior_on_mx_failure", nil)
 		d.Set("mail_from_domain", nil)
+		d.Set("mail_from_domain_status", nil)
 	}
 
 	return diags
@@ -35,6 +35,7 @@ func TestAccSESV2EmailIdentityMailFromAttributesDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "email_identity", dataSourceName, "email_identity"),
 					resource.TestCheckResourceAttrPair(resourceName, "behavior_on_mx_failure", dataSourceName, "behavior_on_mx_failure"),
 					resource.TestCheckResourceAttrPair(resourceName, "mail_from_domain", dataSourceName, "mail_from_domain"),
+					resource.TestCheckResourceAttrPair(resourceName, "mail_from_domain_status", dataSourceName, "mail_from_domain_status"),
 				),
 			},
 		},
@@ -152,6 +152,7 @@ func TestAccSESV2EmailIdentityMailFromAttributes_mailFromDomain(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEmailIdentityMailFromAttributesExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain1.String()),
+					resource.TestCheckResourceAttrSet(resourceName, "mail_from_domain_status"),
 				),
 			},
 			{
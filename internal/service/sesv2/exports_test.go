@@ -8,6 +8,7 @@ var (
 	ResourceAccountVDMAttributes             = resourceAccountVDMAttributes
 	ResourceConfigurationSet                 = resourceConfigurationSet
 	ResourceConfigurationSetEventDestination = resourceConfigurationSetEventDestination
+	ResourceContact                          = resourceContact
 	ResourceContactList                      = resourceContactList
 	ResourceDedicatedIPAssignment            = resourceDedicatedIPAssignment
 	ResourceDedicatedIPPool                  = resourceDedicatedIPPool
@@ -20,6 +21,7 @@ var (
 	FindAccountVDMAttributes                         = findAccountVDMAttributes
 	FindConfigurationSetByID                         = findConfigurationSetByID
 	FindConfigurationSetEventDestinationByTwoPartKey = findConfigurationSetEventDestinationByTwoPartKey
+	FindContactByTwoPartKey                          = findContactByTwoPartKey
 	FindContactListByID                              = findContactListByID
 	FindDedicatedIPByTwoPartKey                      = findDedicatedIPByTwoPartKey
 	FindDedicatedIPPoolByName                        = findDedicatedIPPoolByName
@@ -82,6 +82,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sesv2_configuration_set_event_destination",
 			Name:     "Configuration Set Event Destination",
 		},
+		{
+			Factory:  resourceContact,
+			TypeName: "aws_sesv2_contact",
+			Name:     "Contact",
+		},
 		{
 			Factory:  resourceContactList,
 			TypeName: "aws_sesv2_contact_list",
@@ -107,6 +107,20 @@ func (r *applicationLayerAutomaticResponseResource) Create(ctx context.Context,
 	}
 
 	resourceARN := data.ResourceARN.ValueString()
+
+	// Automatic application layer DDoS mitigation is only available for resources protected by Shield Advanced.
+	if _, err := findProtectionByResourceARN(ctx, conn, resourceARN); err != nil {
+		if tfresource.NotFound(err) {
+			response.Diagnostics.AddError(fmt.Sprintf("enabling Shield Application Layer Automatic Response (%s)", resourceARN), "resource is not protected by Shield Advanced")
+
+			return
+		}
+
+		response.Diagnostics.AddError(fmt.Sprintf("reading Shield Protection (%s)", resourceARN), err.Error())
+
+		return
+	}
+
 	input := &shield.EnableApplicationLayerAutomaticResponseInput{
 		Action:      action,
 		ResourceArn: aws.String(resourceARN),
@@ -57,6 +57,38 @@ func TestAccShieldApplicationLayerAutomaticResponse_basic(t *testing.T) {
 	})
 }
 
+func TestAccShieldApplicationLayerAutomaticResponse_alb(t *testing.T) {
+	ctx := acctest.Context(t)
+	var applicationlayerautomaticresponse types.ApplicationLayerAutomaticResponseConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_application_layer_automatic_response.test"
+	lbResourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationLayerAutomaticResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_alb(rName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(ctx, resourceName, &applicationlayerautomaticresponse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "BLOCK"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, lbResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccShieldApplicationLayerAutomaticResponse_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var applicationlayerautomaticresponse types.ApplicationLayerAutomaticResponseConfiguration
@@ -215,3 +247,70 @@ resource "aws_shield_application_layer_automatic_response" "test" {
 }
 `, rName, action)
 }
+
+func testAccApplicationLayerAutomaticResponseConfig_alb(rName, action string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb" "test" {
+  name            = %[1]q
+  internal        = true
+  security_groups = [aws_security_group.test.id]
+  subnets         = aws_subnet.test[*].id
+
+  enable_deletion_protection = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+
+  lifecycle {
+    ignore_changes = [
+      rule,
+    ]
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_lb.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = aws_lb.test.arn
+}
+
+resource "aws_shield_application_layer_automatic_response" "test" {
+  resource_arn = aws_lb.test.arn
+  action       = %[2]q
+
+  depends_on = [
+    aws_shield_protection.test,
+    aws_wafv2_web_acl_association.test,
+  ]
+}
+`, rName, action))
+}
@@ -74,7 +74,7 @@ func (r *proactiveEngagementResource) Schema(ctx context.Context, request resour
 						"phone_number": schema.StringAttribute{
 							Optional: true,
 							Validators: []validator.String{
-								stringvalidator.RegexMatches(regexache.MustCompile(`^\+[1-9]\d{1,14}$`), ""),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^\+[1-9]\d{1,14}$`), "must be in E.164 format, for example +12358132134"),
 							},
 						},
 					},
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/shield/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -54,6 +55,26 @@ func testAccProactiveEngagement_basic(t *testing.T) {
 	})
 }
 
+func testAccProactiveEngagement_invalidPhoneNumber(t *testing.T) {
+	ctx := acctest.Context(t)
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProactiveEngagementConfig_phoneNumber(address1, "555-0100"),
+				ExpectError: regexache.MustCompile(`must be in E.164 format`),
+			},
+		},
+	})
+}
+
 func testAccProactiveEngagement_disabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	domain := acctest.RandomDomainName()
@@ -224,3 +245,16 @@ resource "aws_shield_proactive_engagement" "test" {
 
 `, rName, email1, email2, enabled)
 }
+
+func testAccProactiveEngagementConfig_phoneNumber(email, phoneNumber string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = true
+
+  emergency_contact {
+    email_address = %[1]q
+    phone_number  = %[2]q
+  }
+}
+`, email, phoneNumber)
+}
@@ -25,6 +25,7 @@ func TestAccShield_serial(t *testing.T) {
 		"ProactiveEngagement": {
 			acctest.CtBasic:      testAccProactiveEngagement_basic,
 			"disabled":           testAccProactiveEngagement_disabled,
+			"invalidPhoneNumber": testAccProactiveEngagement_invalidPhoneNumber,
 			acctest.CtDisappears: testAccProactiveEngagement_disappears,
 		},
 	}
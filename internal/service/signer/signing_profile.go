@@ -247,8 +247,19 @@ func resourceSigningProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SignerClient(ctx)
 
+	// Only an active (or revoked) profile can be canceled.
+	_, err := findSigningProfileByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Profile (%s): %s", d.Id(), err)
+	}
+
 	log.Printf("[DEBUG] Deleting Signer Signing Profile: %s", d.Id())
-	_, err := conn.CancelSigningProfile(ctx, &signer.CancelSigningProfileInput{
+	_, err = conn.CancelSigningProfile(ctx, &signer.CancelSigningProfileInput{
 		ProfileName: aws.String(d.Id()),
 	})
 
@@ -260,6 +271,10 @@ func resourceSigningProfileDelete(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "deleting Signer Signing Profile (%s): %s", d.Id(), err)
 	}
 
+	if _, err := waitSigningProfileCanceled(ctx, conn, d.Id(), signingProfileCanceledTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Signer Signing Profile (%s) delete: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -352,3 +367,36 @@ func findSigningProfileByName(ctx context.Context, conn *signer.Client, name str
 
 	return output, nil
 }
+
+func statusSigningProfile(ctx context.Context, conn *signer.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSigningProfileByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitSigningProfileCanceled(ctx context.Context, conn *signer.Client, name string, timeout time.Duration) (*signer.GetSigningProfileOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.SigningProfileStatusActive, types.SigningProfileStatusRevoked),
+		Target:  []string{},
+		Refresh: statusSigningProfile(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*signer.GetSigningProfileOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
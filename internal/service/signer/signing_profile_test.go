@@ -230,6 +230,43 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_signingMaterial(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf signer.GetSigningProfileOutput
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile.test_sp"
+	certificateResourceName := "aws_acm_certificate.test"
+	privateKeyPEM := acctest.TLSECDSAPrivateKeyPEM(t, "P-384")
+	certificatePEM := acctest.TLSECDSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileConfig_signingMaterial(rName, certificatePEM, privateKeyPEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningProfileExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "signing_material.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "signing_material.0.certificate_arn", certificateResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccPreCheckSingerSigningProfile(ctx context.Context, t *testing.T, platformID string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
 
@@ -369,3 +406,21 @@ resource "aws_signer_signing_profile" "test_sp" {
 }
 `, rName)
 }
+
+func testAccSigningProfileConfig_signingMaterial(rName, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+}
+
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+
+  signing_material {
+    certificate_arn = aws_acm_certificate.test.arn
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(privateKey))
+}
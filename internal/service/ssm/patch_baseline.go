@@ -6,6 +6,7 @@ package ssm
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 
@@ -122,6 +123,12 @@ func resourcePatchBaseline() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"available_security_updates_compliance_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PatchComplianceStatus](),
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -232,6 +239,7 @@ func resourcePatchBaseline() *schema.Resource {
 					"approved_patches_compliance_level",
 					"rejected_patches_action",
 					"approved_patches_enable_non_security",
+					"available_security_updates_compliance_status",
 					names.AttrSource,
 				) {
 					return d.SetNewComputed(names.AttrJSON)
@@ -239,6 +247,17 @@ func resourcePatchBaseline() *schema.Resource {
 
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// AvailableSecurityUpdatesComplianceStatus is only supported for Windows Server.
+				// The attribute is Computed, so only the configured value is checked.
+				if v := d.GetRawConfig().GetAttr("available_security_updates_compliance_status"); v.IsKnown() && !v.IsNull() {
+					if operatingSystem := awstypes.OperatingSystem(d.Get("operating_system").(string)); operatingSystem != awstypes.OperatingSystemWindows {
+						return fmt.Errorf("available_security_updates_compliance_status (%s) is only supported when operating_system is %s, got: %s", v.AsString(), awstypes.OperatingSystemWindows, operatingSystem)
+					}
+				}
+
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 	}
@@ -268,6 +287,10 @@ func resourcePatchBaselineCreate(ctx context.Context, d *schema.ResourceData, me
 		input.ApprovedPatchesEnableNonSecurity = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("available_security_updates_compliance_status"); ok {
+		input.AvailableSecurityUpdatesComplianceStatus = awstypes.PatchComplianceStatus(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -335,6 +358,7 @@ func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta
 		Resource:  "patchbaseline/" + strings.TrimPrefix(d.Id(), "/"),
 	}.String()
 	d.Set(names.AttrARN, arn)
+	d.Set("available_security_updates_compliance_status", output.AvailableSecurityUpdatesComplianceStatus)
 	d.Set(names.AttrDescription, output.Description)
 	if err := d.Set("global_filter", flattenPatchFilterGroup(output.GlobalFilters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting global_filter: %s", err)
@@ -376,6 +400,10 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 			input.ApprovedPatchesEnableNonSecurity = aws.Bool(d.Get("approved_patches_enable_non_security").(bool))
 		}
 
+		if d.HasChange("available_security_updates_compliance_status") {
+			input.AvailableSecurityUpdatesComplianceStatus = awstypes.PatchComplianceStatus(d.Get("available_security_updates_compliance_status").(string))
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
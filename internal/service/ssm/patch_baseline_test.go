@@ -405,6 +405,50 @@ func TestAccSSMPatchBaseline_rejectPatchesAction(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_availableSecurityUpdatesComplianceStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ssmPatch ssm.GetPatchBaselineOutput
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_patch_baseline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, "AMAZON_LINUX_2", "COMPLIANT"),
+				ExpectError: regexache.MustCompile(`available_security_updates_compliance_status \(COMPLIANT\) is only supported when operating_system is WINDOWS`),
+			},
+			{
+				Config: testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, "WINDOWS", "COMPLIANT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &ssmPatch),
+					resource.TestCheckResourceAttr(resourceName, "available_security_updates_compliance_status", "COMPLIANT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, "WINDOWS", "NON_COMPLIANT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &ssmPatch),
+					resource.TestCheckResourceAttr(resourceName, "available_security_updates_compliance_status", "NON_COMPLIANT"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 // testAccSSMPatchBaseline_deleteDefault needs to be serialized with the other
 // Default Patch Baseline acceptance tests because it sets the default patch baseline
 func testAccSSMPatchBaseline_deleteDefault(t *testing.T) {
@@ -733,6 +777,17 @@ resource "aws_ssm_patch_baseline" "test" {
 `, rName)
 }
 
+func testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(rName, operatingSystem, status string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name                                         = "patch-baseline-%[1]s"
+  operating_system                             = %[2]q
+  approved_patches                             = ["KB123456"]
+  available_security_updates_compliance_status = %[3]q
+}
+`, rName, operatingSystem, status)
+}
+
 func testAccPatchBaselineConfig_approvalRuleEmpty(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_patch_baseline" "test" {
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -60,9 +59,6 @@ func (r *resourceApplicationAccessScope) Schema(ctx context.Context, req resourc
 			"authorized_targets": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrID: framework.IDAttribute(),
 			names.AttrScope: schema.StringAttribute{
@@ -168,7 +164,37 @@ func (r *resourceApplicationAccessScope) Read(ctx context.Context, req resource.
 }
 
 func (r *resourceApplicationAccessScope) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	//Update is no-op.
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan, state resourceApplicationAccessScopeData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AuthorizedTargets.Equal(state.AuthorizedTargets) {
+		// PutApplicationAccessScope replaces the authorized targets of an existing scope.
+		in := &ssoadmin.PutApplicationAccessScopeInput{
+			ApplicationArn: plan.ApplicationARN.ValueStringPointer(),
+			Scope:          plan.Scope.ValueStringPointer(),
+		}
+
+		if !plan.AuthorizedTargets.IsNull() {
+			in.AuthorizedTargets = flex.ExpandFrameworkStringValueList(ctx, plan.AuthorizedTargets)
+		}
+
+		_, err := conn.PutApplicationAccessScope(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationAccessScope, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *resourceApplicationAccessScope) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -54,6 +55,54 @@ func TestAccSSOAdminApplicationAccessScope_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplicationAccessScope_authorizedTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_access_scope.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	application2ResourceName := "aws_ssoadmin_application.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAccessScopeConfig_basic(rName, "sso:account:access"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorized_targets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorized_targets.0", applicationResourceName, "application_arn"),
+				),
+			},
+			{
+				Config: testAccApplicationAccessScopeConfig_authorizedTargets(rName, "sso:account:access"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorized_targets.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorized_targets.0", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorized_targets.1", application2ResourceName, "application_arn"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminApplicationAccessScope_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -144,3 +193,30 @@ resource "aws_ssoadmin_application_access_scope" "test" {
 }
 `, rName, testAccApplicationProviderARN, scope)
 }
+
+func testAccApplicationAccessScopeConfig_authorizedTargets(rName, scope string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_application" "test2" {
+  name                     = "%[1]s-2"
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_application_access_scope" "test" {
+  application_arn = aws_ssoadmin_application.test.application_arn
+  authorized_targets = [
+    aws_ssoadmin_application.test.application_arn,
+    aws_ssoadmin_application.test2.application_arn,
+  ]
+  scope = %[3]q
+}
+`, rName, testAccApplicationProviderARN, scope)
+}
@@ -166,6 +166,7 @@ func TestAccSSOAdminCustomerManagedPolicyAttachment_multipleManagedPolicies(t *t
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCustomerManagedPolicyAttachmentExists(ctx, resource1Name),
 					testAccCheckCustomerManagedPolicyAttachmentExists(ctx, resource2Name),
+					testAccCheckPermissionSetProvisioned(ctx, permissionSetResourceName),
 					resource.TestCheckResourceAttr(resource2Name, "customer_managed_policy_reference.0.name", rNamePolicy3),
 					resource.TestCheckResourceAttrPair(resource2Name, "instance_arn", permissionSetResourceName, "instance_arn"),
 					resource.TestCheckResourceAttrPair(resource2Name, "permission_set_arn", permissionSetResourceName, names.AttrARN),
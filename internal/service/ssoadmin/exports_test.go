@@ -15,5 +15,6 @@ var (
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
+	FindPermissionSetProvisioningPending       = findPermissionSetProvisioningPending
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
 )
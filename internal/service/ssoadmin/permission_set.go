@@ -309,6 +309,38 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissi
 	return nil
 }
 
+// findPermissionSetProvisioningPending returns whether any account that the permission set is provisioned to
+// does not yet have the latest version of the permission set provisioned.
+func findPermissionSetProvisioningPending(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) (bool, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:        aws.String(instanceARN),
+		PermissionSetArn:   aws.String(permissionSetARN),
+		ProvisioningStatus: awstypes.ProvisioningStatusLatestPermissionSetNotProvisioned,
+	}
+
+	paginator := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return false, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(page.AccountIds) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func findPermissionSetProvisioningStatus(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string) (*awstypes.PermissionSetProvisioningStatus, error) {
 	input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
 		InstanceArn:                     aws.String(instanceARN),
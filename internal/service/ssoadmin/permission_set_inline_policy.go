@@ -32,7 +32,11 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourcePermissionSetInlinePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("track_provisioning", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -64,6 +68,24 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"provisioning_pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"track_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// If any account is not running the latest version of the permission set, plan an update so that it is (re)provisioned.
+			if d.Id() != "" && d.Get("provisioning_pending").(bool) {
+				return d.SetNew("provisioning_pending", false)
+			}
+
+			return nil
 		},
 	}
 }
@@ -72,6 +94,11 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
 
+	// Changing only track_provisioning does not need the permission set to be (re)provisioned.
+	if !d.IsNewResource() && !d.HasChanges("inline_policy", "provisioning_pending") {
+		return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
+	}
+
 	policy, err := structure.NormalizeJsonString(d.Get("inline_policy").(string))
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -131,6 +158,28 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 	d.Set("instance_arn", instanceARN)
 	d.Set("permission_set_arn", permissionSetARN)
 
+	// All accounts have just been (re)provisioned on creation.
+	// Otherwise only look up the provisioning status when asked to, as it costs an additional API call.
+	if d.IsNewResource() || !d.Get("track_provisioning").(bool) {
+		d.Set("provisioning_pending", false)
+
+		return diags
+	}
+
+	pending, err := findPermissionSetProvisioningPending(ctx, conn, permissionSetARN, instanceARN)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing Inline Policy (%s) from state", permissionSetARN, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Permission Set (%s) provisioning status: %s", permissionSetARN, err)
+	}
+
+	d.Set("provisioning_pending", pending)
+
 	return diags
 }
 
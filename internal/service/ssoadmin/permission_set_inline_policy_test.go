@@ -39,6 +39,8 @@ func TestAccSSOAdminPermissionSetInlinePolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, names.AttrARN),
 					resource.TestMatchResourceAttr(resourceName, "inline_policy", regexache.MustCompile("s3:ListAllMyBuckets")),
 					resource.TestMatchResourceAttr(resourceName, "inline_policy", regexache.MustCompile("s3:GetBucketLocation")),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_pending", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "track_provisioning", acctest.CtFalse),
 				),
 			},
 			{
@@ -72,12 +74,15 @@ func TestAccSSOAdminPermissionSetInlinePolicy_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPermissionSetInlinePolicyExists(ctx, resourceName),
 					resource.TestMatchResourceAttr(resourceName, "inline_policy", regexache.MustCompile("s3:ListAllMyBuckets")),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_pending", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "track_provisioning", acctest.CtTrue),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"track_provisioning"},
 			},
 		},
 	})
@@ -244,6 +249,7 @@ resource "aws_ssoadmin_permission_set_inline_policy" "test" {
   inline_policy      = data.aws_iam_policy_document.test.json
   instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
   permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  track_provisioning = true
 }
 `, rName)
 }
@@ -328,6 +328,34 @@ func testAccCheckSOAdminPermissionSetExists(ctx context.Context, n string) resou
 	}
 }
 
+func testAccCheckPermissionSetProvisioned(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		permissionSetARN, instanceARN, err := tfssoadmin.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		pending, err := tfssoadmin.FindPermissionSetProvisioningPending(ctx, conn, permissionSetARN, instanceARN)
+
+		if err != nil {
+			return err
+		}
+
+		if pending {
+			return fmt.Errorf("SSO Permission Set %s has accounts pending provisioning", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccPermissionSetConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
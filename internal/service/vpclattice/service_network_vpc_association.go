@@ -6,6 +6,7 @@ package vpclattice
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -80,7 +82,13 @@ func resourceServiceNetworkVPCAssociation() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			// UpdateServiceNetworkVpcAssociation requires at least one security group.
+			customdiff.ForceNewIfChange(names.AttrSecurityGroupIDs, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -150,20 +158,21 @@ func resourceServiceNetworkVPCAssociationRead(ctx context.Context, d *schema.Res
 func resourceServiceNetworkVPCAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange(names.AttrSecurityGroupIDs) {
 		in := &vpclattice.UpdateServiceNetworkVpcAssociationInput{
+			SecurityGroupIds:                       flex.ExpandStringValueList(d.Get(names.AttrSecurityGroupIDs).([]interface{})),
 			ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
 		}
 
-		if d.HasChange(names.AttrSecurityGroupIDs) {
-			in.SecurityGroupIds = flex.ExpandStringValueList(d.Get(names.AttrSecurityGroupIDs).([]interface{}))
-		}
-
 		log.Printf("[DEBUG] Updating VPCLattice ServiceNetwork VPC Association (%s): %#v", d.Id(), in)
 		_, err := conn.UpdateServiceNetworkVpcAssociation(ctx, in)
 		if err != nil {
 			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionUpdating, ResNameServiceNetworkVPCAssociation, d.Id(), err)
 		}
+
+		if _, err := waitServiceNetworkVPCAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForUpdate, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceServiceNetworkVPCAssociationRead(ctx, d, meta)...)
@@ -236,6 +245,27 @@ func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclatti
 	return nil, err
 }
 
+func waitServiceNetworkVPCAssociationUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusUpdateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
+		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		if out.Status == types.ServiceNetworkVpcAssociationStatusUpdateFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(out.FailureCode), aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
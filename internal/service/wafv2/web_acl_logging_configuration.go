@@ -172,7 +172,57 @@ func resourceWebACLLoggingConfiguration() *schema.Resource {
 				},
 			}
 		},
+
+		CustomizeDiff: validateLoggingFilterConditions,
+	}
+}
+
+// validateLoggingFilterConditions ensures that each logging filter condition
+// specifies exactly one of action_condition or label_name_condition.
+func validateLoggingFilterConditions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("logging_filter")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	filters, ok := tfMap[names.AttrFilter].(*schema.Set)
+	if !ok {
+		return nil
 	}
+
+	for _, tfMapRaw := range filters.List() {
+		filter, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditions, ok := filter[names.AttrCondition].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range conditions.List() {
+			condition, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			n := 0
+			if v, ok := condition["action_condition"].([]interface{}); ok && len(v) > 0 {
+				n++
+			}
+			if v, ok := condition["label_name_condition"].([]interface{}); ok && len(v) > 0 {
+				n++
+			}
+
+			if n != 1 {
+				return fmt.Errorf("each logging_filter condition must specify exactly one of action_condition or label_name_condition")
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceWebACLLoggingConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -411,6 +411,82 @@ func TestSecondJSONUnlessEquivalent(t *testing.T) {
 	}
 }
 
+func TestPolicyStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		policy1  string
+		policy2  string
+		expected bool
+	}{
+		{
+			name:     "both empty",
+			policy1:  "",
+			policy2:  " ",
+			expected: true,
+		},
+		{
+			name:     "empty object and empty string",
+			policy1:  "{}",
+			policy2:  "",
+			expected: true,
+		},
+		{
+			name:     "whitespace",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  "{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": [\n    {\n      \"Effect\": \"Allow\",\n      \"Principal\": {\"Service\": \"ec2.amazonaws.com\"},\n      \"Action\": \"sts:AssumeRole\"\n    }\n  ]\n}",
+			expected: true,
+		},
+		{
+			name:     "reordered keys",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Statement":[{"Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"},"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			expected: true,
+		},
+		{
+			name:     "reordered principals",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		{
+			name:     "single statement object and array",
+			policy1:  `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		{
+			name:     "single element action array and string",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":["sts:AssumeRole"]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		{
+			name:     "different principals",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: false,
+		},
+		{
+			name:     "invalid JSON",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := PolicyStringsEquivalent(testCase.policy1, testCase.policy2), testCase.expected; got != want {
+				t.Errorf("PolicyStringsEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestNormalizeJSONOrYAMLString(t *testing.T) {
 	t.Parallel()
 
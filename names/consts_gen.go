@@ -73,6 +73,7 @@ const (
 	DMS                          = "dms"
 	DRS                          = "drs"
 	DS                           = "ds"
+	DSQL                         = "dsql"
 	DataBrew                     = "databrew"
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
@@ -328,6 +329,7 @@ const (
 	DMSServiceID                          = "Database Migration Service"
 	DRSServiceID                          = "DRS"
 	DSServiceID                           = "Directory Service"
+	DSQLServiceID                         = "DSQL"
 	DataBrewServiceID                     = "DataBrew"
 	DataExchangeServiceID                 = "DataExchange"
 	DataPipelineServiceID                 = "Data Pipeline"
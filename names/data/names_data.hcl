@@ -2899,6 +2899,28 @@ service "ds" {
   brand                    = "AWS"
 }
 
+service "dsql" {
+  sdk {
+    id = "DSQL"
+  }
+
+  names {
+    provider_name_upper = "DSQL"
+    human_friendly      = "Aurora DSQL"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListClusters"
+  }
+
+  resource_prefix {
+    correct = "aws_dsql_"
+  }
+
+  doc_prefix = ["dsql_"]
+  brand      = "Amazon"
+}
+
 service "dax" {
   sdk {
     id = "DAX"